@@ -0,0 +1,215 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// leadingColors and trailingColors match color escape sequences around the
+// content of a cell, which are kept with the first and last parts of it.
+var (
+	leadingColors  = regexp.MustCompile(`^(?:\033\[(?:\d+(?:;\d+)*)?m)+`)
+	trailingColors = regexp.MustCompile(`(?:\033\[(?:\d+(?:;\d+)*)?m)+$`)
+)
+
+// decimalWidths holds the widths of the parts of the cells of a column with
+// AlignDecimal, as split by decimalParts.
+type decimalWidths struct {
+	integer, fraction, suffix int
+}
+
+// decimalParts splits a value for AlignDecimal into the part up to the
+// decimal point, the fraction (with any exponent), and whatever follows,
+// such as a unit; point is the decimal point, "." if empty.  Separators
+// between digits, such as for thousands, are taken into the integer part.
+// Text without a number is all in the first part.
+func decimalParts(value, point string) (integer, fraction, suffix string) {
+	if point == "" {
+		point = "."
+	}
+	lead := leadingColors.FindString(value)
+	value = value[len(lead):]
+	trail := ""
+	if loc := trailingColors.FindStringIndex(value); loc != nil {
+		trail = value[loc[0]:]
+		value = value[:loc[0]]
+	}
+
+	start := strings.IndexFunc(value, isDigit)
+	if start < 0 {
+		return lead + value + trail, "", ""
+	}
+	end := start
+	if strings.HasSuffix(value[:start], point) {
+		end = start - len(point)
+	} else {
+		for end < len(value) {
+			r, size := utf8.DecodeRuneInString(value[end:])
+			if isDigit(r) {
+				end += size
+				continue
+			}
+			next, _ := utf8.DecodeRuneInString(value[end+size:])
+			if strings.ContainsRune(digitSeparators, r) && !strings.HasPrefix(value[end:], point) && isDigit(next) {
+				end += size
+				continue
+			}
+			break
+		}
+	}
+	integer, rest := value[:end], value[end:]
+
+	n := 0
+	if strings.HasPrefix(rest, point) && len(rest) > len(point) && isDigit(rune(rest[len(point)])) {
+		n = len(point)
+		for n < len(rest) && isDigit(rune(rest[n])) {
+			n++
+		}
+	}
+	if n < len(rest) && (rest[n] == 'e' || rest[n] == 'E') {
+		m := n + 1
+		if m < len(rest) && (rest[m] == '+' || rest[m] == '-') {
+			m++
+		}
+		if m < len(rest) && isDigit(rune(rest[m])) {
+			for m < len(rest) && isDigit(rune(rest[m])) {
+				m++
+			}
+			n = m
+		}
+	}
+	return lead + integer, rest[:n], rest[n:] + trail
+}
+
+// digitSeparators are the characters which may separate groups of digits.
+const digitSeparators = ",.'_ \u00a0\u202f’"
+
+// isDigit reports whether r is an ASCII digit.
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// textWidth returns the display width of text, ignoring color escapes.
+func textWidth(s string) int {
+	return runewidth.StringWidth(filterColorCodes(s))
+}
+
+// alignment returns the alignment which a cell is to be rendered with, before
+// resolving AlignAuto.
+func (s *renderStyle) alignment(c *Cell) TableAlignment {
+	if c.alignment != nil {
+		return *c.alignment
+	}
+	return s.Alignment
+}
+
+// resolveAlignment returns the alignment to use for a cell in a column, with
+// AlignAuto resolved according to the values in the column.
+func (s *renderStyle) resolveAlignment(a TableAlignment, column int) TableAlignment {
+	if a != AlignAuto {
+		return a
+	}
+	if s.numericColumns[column] {
+		return AlignRight
+	}
+	return AlignLeft
+}
+
+// measureAlignments finds which columns of the table hold only numbers, for
+// AlignAuto, and the widths of the parts of cells with AlignDecimal, which
+// may widen their columns.
+func (s *renderStyle) measureAlignments(table *Table) {
+	s.numericColumns = map[int]bool{}
+	nonNumeric := map[int]bool{}
+	for _, e := range table.elements {
+		row, ok := e.(*Row)
+		if !ok || row.header {
+			continue
+		}
+		for _, c := range row.cells {
+			if c.colSpan > 1 || c.empty || filterColorCodes(c.formattedValue) == "" {
+				continue
+			}
+			if _, ok := cellNumber(c); ok {
+				s.numericColumns[c.column] = true
+			} else {
+				nonNumeric[c.column] = true
+			}
+		}
+	}
+	for column := range nonNumeric {
+		delete(s.numericColumns, column)
+	}
+
+	s.decimalWidths = map[int]decimalWidths{}
+	for _, e := range table.elements {
+		row, ok := e.(*Row)
+		if !ok || row.header {
+			continue
+		}
+		for _, c := range row.cells {
+			if c.colSpan > 1 || s.resolveAlignment(s.alignment(c), c.column) != AlignDecimal {
+				continue
+			}
+			integer, fraction, suffix := decimalParts(c.formattedValue, c.decimalPoint)
+			w := s.decimalWidths[c.column]
+			if n := textWidth(integer); n > w.integer {
+				w.integer = n
+			}
+			if n := textWidth(fraction); n > w.fraction {
+				w.fraction = n
+			}
+			if n := textWidth(suffix); n > w.suffix {
+				w.suffix = n
+			}
+			s.decimalWidths[c.column] = w
+		}
+	}
+	for column, w := range s.decimalWidths {
+		if n := w.integer + w.fraction + w.suffix; n > s.cellWidths[column] {
+			s.cellWidths[column] = n
+		}
+	}
+}
+
+// decimalHeaders returns a copy of a header row in which cells with
+// AlignDecimal are aligned right instead, as headers are not lined up on the
+// decimal point, nor counted in the widths of the parts of the column.
+func (s *renderStyle) decimalHeaders(r *Row) *Row {
+	right := AlignRight
+	headers := *r
+	headers.cells = make([]*Cell, len(r.cells))
+	for i, c := range r.cells {
+		copied := *c
+		if s.resolveAlignment(s.alignment(c), c.column) == AlignDecimal {
+			copied.alignment = &right
+		}
+		headers.cells[i] = &copied
+	}
+	return &headers
+}
+
+// alignDecimal returns the content of a cell with AlignDecimal, padded to
+// the width; cells spanning several columns are aligned right instead.
+func (s *renderStyle) alignDecimal(c *Cell, width int) string {
+	if c.colSpan > 1 {
+		if l := width - c.Width(); l > 0 {
+			return strings.Repeat(" ", l) + c.formattedValue
+		}
+		return c.formattedValue
+	}
+	w := s.decimalWidths[c.column]
+	integer, fraction, suffix := decimalParts(c.formattedValue, c.decimalPoint)
+	left := width - (w.integer + w.fraction + w.suffix) + w.integer - textWidth(integer)
+	if left < 0 {
+		left = 0
+	}
+	return strings.Repeat(" ", left) + integer +
+		fraction + strings.Repeat(" ", w.fraction-textWidth(fraction)) +
+		suffix + strings.Repeat(" ", w.suffix-textWidth(suffix))
+}
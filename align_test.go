@@ -0,0 +1,86 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestAlignDecimal(t *testing.T) {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Value", "Size")
+	table.AddRow("a", 1.5, "1.5 GB")
+	table.AddRow("b", "200", "200 MB")
+	table.AddRow("c", "-3.125", "\033[31m12.25 KB\033[0m")
+	table.AddRow("d", "n/a", "")
+	table.SetAlign(AlignDecimal, 2, 3)
+
+	expected := "" +
+		"+------+---------+-----------+\n" +
+		"| Name | Value   | Size      |\n" +
+		"+------+---------+-----------+\n" +
+		"| a    |   1.50  |   1.5  GB |\n" +
+		"| b    | 200     | 200    MB |\n" +
+		"| c    |  -3.125 |  \033[31m12.25 KB\033[0m |\n" +
+		"| d    | n/a     |           |\n" +
+		"+------+---------+-----------+\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestAlignDecimalHeadersAndSpans(t *testing.T) {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.Style.Alignment = AlignDecimal
+	table.AddHeaders("Load", "Size")
+	table.AddRow(1.5, "10.25")
+	table.AddRow(CreateCell("12345.678", &CellStyle{Alignment: AlignDecimal, ColSpan: 2}))
+	table.AddRow(200, "3")
+
+	expected := "" +
+		"+--------+-------+\n" +
+		"|   Load |  Size |\n" +
+		"+--------+-------+\n" +
+		"|   1.50 | 10.25 |\n" +
+		"|      12345.678 |\n" +
+		"| 200    |  3    |\n" +
+		"+--------+-------+\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestAlignAuto(t *testing.T) {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.Style.Alignment = AlignAuto
+	table.AddHeaders("Name", "Count", "Mixed")
+	table.AddRow("alpha", 10, "1")
+	table.AddRow("beta", uint8(7), "x")
+	table.AddRow("gamma", "", "2")
+
+	expected := "" +
+		"+-------+-------+-------+\n" +
+		"| Name  | Count | Mixed |\n" +
+		"+-------+-------+-------+\n" +
+		"| alpha |    10 | 1     |\n" +
+		"| beta  |     7 | x     |\n" +
+		"| gamma |       | 2     |\n" +
+		"+-------+-------+-------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeHTML()
+	expected = "" +
+		"<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th align='left'>Name</th><th align='right'>Count</th><th align='left'>Mixed</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td align='left'>alpha</td><td align='right'>10</td><td align='left'>1</td></tr>\n" +
+		"<tr><td align='left'>beta</td><td align='right'>7</td><td align='left'>x</td></tr>\n" +
+		"<tr><td align='left'>gamma</td><td align='right'></td><td align='left'>2</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+	checkRendersTo(t, table, expected)
+}
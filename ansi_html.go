@@ -0,0 +1,257 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// HTMLANSIMode selects how SGR (color and emphasis) escape sequences in the
+// content of cells are represented in HTML output.
+type HTMLANSIMode int
+
+const (
+	// HTMLANSIStyles converts escape sequences into span elements with
+	// inline styles; this is the default.
+	HTMLANSIStyles HTMLANSIMode = iota
+	// HTMLANSIClasses converts escape sequences into span elements with
+	// classes such as "ansi-bold" and "ansi-fg-red", for styling from a
+	// stylesheet.  Colors outside the basic sixteen have no class, so are
+	// still given as inline styles.
+	HTMLANSIClasses
+	// HTMLANSIStrip removes escape sequences.
+	HTMLANSIStrip
+)
+
+// ansiColorNames are the names of the eight basic terminal colors, in SGR
+// order; they are used to build CSS class names such as "ansi-fg-red".
+var ansiColorNames = [8]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// ansiPalette gives the CSS colors used for the basic terminal colors, taken
+// from the xterm defaults.
+var ansiPalette = map[string]string{
+	"black": "#000000", "red": "#cd0000", "green": "#00cd00", "yellow": "#cdcd00",
+	"blue": "#0000ee", "magenta": "#cd00cd", "cyan": "#00cdcd", "white": "#e5e5e5",
+	"bright-black": "#7f7f7f", "bright-red": "#ff0000", "bright-green": "#00ff00",
+	"bright-yellow": "#ffff00", "bright-blue": "#5c5cff", "bright-magenta": "#ff00ff",
+	"bright-cyan": "#00ffff", "bright-white": "#ffffff",
+}
+
+// sgrColor is a color set by an SGR sequence: either one of the sixteen
+// named colors, or an arbitrary RGB value.
+type sgrColor struct {
+	name string
+	rgb  string
+}
+
+// css returns the CSS value for the color.
+func (c sgrColor) css() string {
+	if c.name != "" {
+		return ansiPalette[c.name]
+	}
+	return c.rgb
+}
+
+// sgrState is the set of SGR attributes in effect at some point in a string.
+type sgrState struct {
+	bold      bool
+	dim       bool
+	italic    bool
+	underline bool
+	strike    bool
+	fg        sgrColor
+	bg        sgrColor
+}
+
+// apply updates the state per the parameters of one SGR escape sequence.
+func (s *sgrState) apply(params []int) {
+	for i := 0; i < len(params); i++ {
+		p := params[i]
+		switch {
+		case p == 0:
+			*s = sgrState{}
+		case p == 1:
+			s.bold = true
+		case p == 2:
+			s.dim = true
+		case p == 3:
+			s.italic = true
+		case p == 4:
+			s.underline = true
+		case p == 9:
+			s.strike = true
+		case p == 22:
+			s.bold, s.dim = false, false
+		case p == 23:
+			s.italic = false
+		case p == 24:
+			s.underline = false
+		case p == 29:
+			s.strike = false
+		case p >= 30 && p <= 37:
+			s.fg = sgrColor{name: ansiColorNames[p-30]}
+		case p == 38:
+			s.fg, i = extendedColor(params, i)
+		case p == 39:
+			s.fg = sgrColor{}
+		case p >= 40 && p <= 47:
+			s.bg = sgrColor{name: ansiColorNames[p-40]}
+		case p == 48:
+			s.bg, i = extendedColor(params, i)
+		case p == 49:
+			s.bg = sgrColor{}
+		case p >= 90 && p <= 97:
+			s.fg = sgrColor{name: "bright-" + ansiColorNames[p-90]}
+		case p >= 100 && p <= 107:
+			s.bg = sgrColor{name: "bright-" + ansiColorNames[p-100]}
+		}
+	}
+}
+
+// extendedColor parses the 256-color (5;n) or truecolor (2;r;g;b) arguments
+// following the 38 or 48 parameter at index i, returning the color and the
+// index of the last parameter consumed.
+func extendedColor(params []int, i int) (sgrColor, int) {
+	if i+2 < len(params) && params[i+1] == 5 {
+		return color256(params[i+2]), i + 2
+	}
+	if i+4 < len(params) && params[i+1] == 2 {
+		return sgrColor{rgb: fmt.Sprintf("#%02x%02x%02x",
+			params[i+2]&0xff, params[i+3]&0xff, params[i+4]&0xff)}, i + 4
+	}
+	// Malformed; skip the rest of the sequence rather than misreading the
+	// remaining arguments as attributes.
+	return sgrColor{}, len(params)
+}
+
+// color256 returns the color for an index into the xterm 256-color palette.
+func color256(n int) sgrColor {
+	switch {
+	case n < 0 || n > 255:
+		return sgrColor{}
+	case n < 8:
+		return sgrColor{name: ansiColorNames[n]}
+	case n < 16:
+		return sgrColor{name: "bright-" + ansiColorNames[n-8]}
+	case n < 232:
+		// 6x6x6 color cube
+		levels := [6]int{0, 95, 135, 175, 215, 255}
+		n -= 16
+		return sgrColor{rgb: fmt.Sprintf("#%02x%02x%02x", levels[n/36], levels[n/6%6], levels[n%6])}
+	default:
+		// grayscale ramp
+		v := 8 + (n-232)*10
+		return sgrColor{rgb: fmt.Sprintf("#%02x%02x%02x", v, v, v)}
+	}
+}
+
+// attributes returns the class and style attributes, with leading spaces,
+// which represent the state in the given mode; both are empty if no
+// attributes are in effect.
+func (s *sgrState) attributes(mode HTMLANSIMode) string {
+	classes := []string{}
+	styles := []string{}
+	flag := func(on bool, class, style string) {
+		if !on {
+			return
+		}
+		if mode == HTMLANSIClasses {
+			classes = append(classes, class)
+		} else {
+			styles = append(styles, style)
+		}
+	}
+	color := func(c sgrColor, kind, property string) {
+		if c.name == "" && c.rgb == "" {
+			return
+		}
+		if mode == HTMLANSIClasses && c.name != "" {
+			classes = append(classes, "ansi-"+kind+"-"+c.name)
+		} else {
+			styles = append(styles, property+": "+c.css())
+		}
+	}
+	flag(s.bold, "ansi-bold", "font-weight: bold")
+	flag(s.dim, "ansi-dim", "opacity: 0.6")
+	flag(s.italic, "ansi-italic", "font-style: italic")
+	switch {
+	case s.underline && s.strike:
+		flag(true, "ansi-underline ansi-strike", "text-decoration: underline line-through")
+	case s.underline:
+		flag(true, "ansi-underline", "text-decoration: underline")
+	case s.strike:
+		flag(true, "ansi-strike", "text-decoration: line-through")
+	}
+	color(s.fg, "fg", "color")
+	color(s.bg, "bg", "background-color")
+
+	attrs := ""
+	if len(classes) > 0 {
+		attrs += " class=\"" + strings.Join(classes, " ") + "\""
+	}
+	if len(styles) > 0 {
+		attrs += " style=\"" + strings.Join(styles, "; ") + "\""
+	}
+	return attrs
+}
+
+// parseSGR returns the numeric parameters of an SGR escape sequence which
+// has been matched by colorFilter; an empty parameter list means reset.
+func parseSGR(seq string) []int {
+	body := strings.TrimSuffix(strings.TrimPrefix(seq, "\033["), "m")
+	if body == "" {
+		return []int{0}
+	}
+	fields := strings.Split(body, ";")
+	params := make([]int, 0, len(fields))
+	for _, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			n = 0
+		}
+		params = append(params, n)
+	}
+	return params
+}
+
+// ansiToHTML returns s escaped for HTML, with SGR escape sequences replaced,
+// per mode, by span elements.  Spans are never nested: each change of
+// attributes closes any open span before opening a new one, and any span
+// still open at the end of the string is closed, so the output is always
+// well-formed however the escape sequences were arranged.
+func ansiToHTML(s string, mode HTMLANSIMode) string {
+	if mode == HTMLANSIStrip {
+		return html.EscapeString(filterColorCodes(s))
+	}
+	matches := colorFilter.FindAllStringIndex(s, -1)
+	if matches == nil {
+		return html.EscapeString(s)
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(s)+64))
+	state := sgrState{}
+	open := false
+	last := 0
+	for _, m := range matches {
+		buf.WriteString(html.EscapeString(s[last:m[0]]))
+		last = m[1]
+		state.apply(parseSGR(s[m[0]:m[1]]))
+		if open {
+			buf.WriteString("</span>")
+			open = false
+		}
+		if attrs := state.attributes(mode); attrs != "" {
+			buf.WriteString("<span" + attrs + ">")
+			open = true
+		}
+	}
+	buf.WriteString(html.EscapeString(s[last:]))
+	if open {
+		buf.WriteString("</span>")
+	}
+	return buf.String()
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestANSIToHTML(t *testing.T) {
+	tests := []struct {
+		in   string
+		mode HTMLANSIMode
+		out  string
+	}{
+		{"a<b", HTMLANSIStyles, "a&lt;b"},
+		{"\033[31mred\033[0m", HTMLANSIStyles, "<span style=\"color: #cd0000\">red</span>"},
+		{"\033[31mred\033[0m", HTMLANSIClasses, "<span class=\"ansi-fg-red\">red</span>"},
+		{"\033[31mred\033[0m", HTMLANSIStrip, "red"},
+		{"x\033[1;94mbold\033[22mplain\033[m", HTMLANSIClasses,
+			"x<span class=\"ansi-bold ansi-fg-bright-blue\">bold</span><span class=\"ansi-fg-bright-blue\">plain</span>"},
+		{"\033[4;41munclosed", HTMLANSIClasses, "<span class=\"ansi-underline ansi-bg-red\">unclosed</span>"},
+		{"\033[38;5;196mhot\033[0m", HTMLANSIClasses, "<span style=\"color: #ff0000\">hot</span>"},
+		{"\033[38;5;9mnamed\033[0m", HTMLANSIClasses, "<span class=\"ansi-fg-bright-red\">named</span>"},
+		{"\033[48;5;244mgray\033[0m", HTMLANSIStyles, "<span style=\"background-color: #808080\">gray</span>"},
+		{"\033[1;38;2;10;20;300mtrue\033[0m", HTMLANSIStyles,
+			"<span style=\"font-weight: bold; color: #0a142c\">true</span>"},
+		{"\033[38;5mbad", HTMLANSIStyles, "bad"},
+	}
+	for _, test := range tests {
+		got := ansiToHTML(test.in, test.mode)
+		if got != test.out {
+			t.Errorf("Invalid ANSI translation; expected %q but got %q from input %q",
+				test.out, got, test.in)
+		}
+	}
+}
+
+func TestTableColorsHTML(t *testing.T) {
+	expected := "<table class=\"termtable\">\n" +
+		"<tbody>\n" +
+		"<tr><td><span style=\"font-weight: bold\">up</span></td><td>ok</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.SetModeHTML()
+	table.AddRow("\033[1mup\033[0m", "ok")
+
+	output := table.Render()
+	if output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+}
+
+func TestTableColorsMarkdownAndCSV(t *testing.T) {
+	table := CreateTable()
+	table.SetModeMarkdown()
+	table.AddHeaders("Name", "State")
+	table.AddRow("a,b", "\033[31mdown\033[0m")
+
+	expected := "" +
+		"| Name | State |\n" +
+		"| ---- | ----- |\n" +
+		"| a,b  | down  |\n"
+	checkRendersTo(t, table, expected)
+
+	table = CreateTable()
+	table.SetModeCSV()
+	table.AddTitle("ignored")
+	table.AddHeaders("Name", "State")
+	table.AddRow("a,b", "\033[31mdown\033[0m")
+	table.AddSeparator()
+	table.AddRow("c", 1.5)
+
+	expected = "" +
+		"Name,State\n" +
+		"\"a,b\",down\n" +
+		"c,1.50\n"
+	checkRendersTo(t, table, expected)
+}
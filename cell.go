@@ -3,11 +3,16 @@
 package termtables
 
 import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/hex"
 	"fmt"
 	"math"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	runewidth "github.com/mattn/go-runewidth"
@@ -34,9 +39,14 @@ var (
 // do not create one "const" Cell to add it multiple times.
 type Cell struct {
 	column         int
+	value          interface{}
 	formattedValue string
+	decimalPoint   string
+	empty          bool
 	alignment      *TableAlignment
 	colSpan        int
+	rowSpan        int
+	class          string
 }
 
 // CreateCell returns a Cell where the content is the supplied value, with the
@@ -49,20 +59,34 @@ func CreateCell(v interface{}, style *CellStyle) *Cell {
 }
 
 func createCell(column int, v interface{}, style *CellStyle) *Cell {
-	cell := &Cell{column: column, formattedValue: renderValue(v), colSpan: 1}
+	cell := &Cell{column: column, value: v, formattedValue: renderValue(v), empty: isEmptyValue(v), colSpan: 1}
 	if style != nil {
 		cell.alignment = &style.Alignment
 		if style.ColSpan != 0 {
 			cell.colSpan = style.ColSpan
 		}
+		cell.rowSpan = style.RowSpan
+		cell.class = style.HTMLClass
 	}
 	return cell
 }
 
 // Width returns the width of the content of the cell, measured in runes as best
-// as possible considering sophisticated Unicode.
+// as possible considering sophisticated Unicode; for content on several
+// lines, it is the width of the widest line.
 func (c *Cell) Width() int {
-	return runewidth.StringWidth(filterColorCodes(c.formattedValue))
+	width := 0
+	for _, line := range strings.Split(c.formattedValue, "\n") {
+		if w := runewidth.StringWidth(filterColorCodes(line)); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// lines returns the lines of the content of the cell.
+func (c *Cell) lines() []string {
+	return strings.Split(c.formattedValue, "\n")
 }
 
 // Filter out terminal bold/color sequences in a string.
@@ -111,7 +135,7 @@ func (c *Cell) alignCell(style *renderStyle) string {
 		}
 	}
 
-	switch *c.alignment {
+	switch style.resolveAlignment(*c.alignment, c.column) {
 
 	default:
 		buffer += c.formattedValue
@@ -141,28 +165,127 @@ func (c *Cell) alignCell(style *renderStyle) string {
 		buffer += strings.Repeat(" ", left)
 		buffer += c.formattedValue
 		buffer += strings.Repeat(" ", right)
+
+	case AlignDecimal:
+		buffer += style.alignDecimal(c, width)
 	}
 
 	return buffer
 }
 
-// Format the raw value as a string depending on the type
+// NilPlaceholder is shown in terminal, Markdown and HTML output for nil
+// values added to tables, including nil pointers, in columns without a
+// placeholder for empty values set with SetEmptyPlaceholder or
+// SetColumnEmptyPlaceholder; it is empty by default.
+var NilPlaceholder = ""
+
+// renderValue formats a value added to a table as a string, depending on its
+// type:
+//
+//   - nil, and nil pointers, as nothing; they are shown as NilPlaceholder, or
+//     the placeholder for empty values, by Table.withDisplayValues;
+//   - strings as they are, and booleans as true or false;
+//   - integers in decimal, and floating-point and complex numbers with two
+//     decimals;
+//   - byte slices as text if they are valid UTF-8, and otherwise in
+//     hexadecimal with a leading 0x;
+//   - times per RFC 3339, and durations as by their String method, as 1m30s;
+//   - Tables as nothing, as they are drawn when the table holding them is
+//     rendered; see Row.Render;
+//   - errors by their Error method, other fmt.Stringers by their String
+//     method, encoding.TextMarshalers by their MarshalText method, and
+//     driver.Valuers, such as sql.NullString, as their Value, in that order
+//     of preference;
+//   - other pointers as what they point to, and other types whose underlying
+//     type is one of the above as that type;
+//   - anything else as by fmt's %v verb.
 func renderValue(v interface{}) string {
 	switch vv := v.(type) {
+	case nil:
+		return ""
 	case string:
 		return vv
 	case bool:
 		return strconv.FormatBool(vv)
 	case int:
 		return strconv.Itoa(vv)
+	case int8:
+		return strconv.FormatInt(int64(vv), 10)
+	case int16:
+		return strconv.FormatInt(int64(vv), 10)
+	case int32:
+		return strconv.FormatInt(int64(vv), 10)
 	case int64:
 		return strconv.FormatInt(vv, 10)
+	case uint:
+		return strconv.FormatUint(uint64(vv), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(vv), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(vv), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(vv), 10)
 	case uint64:
 		return strconv.FormatUint(vv, 10)
+	case uintptr:
+		return strconv.FormatUint(uint64(vv), 10)
+	case float32:
+		return strconv.FormatFloat(float64(vv), 'f', 2, 32)
 	case float64:
 		return strconv.FormatFloat(vv, 'f', 2, 64)
+	case complex64:
+		return strconv.FormatComplex(complex128(vv), 'f', 2, 64)
+	case complex128:
+		return strconv.FormatComplex(vv, 'f', 2, 128)
+	case []byte:
+		if utf8.Valid(vv) {
+			return string(vv)
+		}
+		return "0x" + hex.EncodeToString(vv)
+	case time.Time:
+		return vv.Format(time.RFC3339)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return ""
+	}
+	switch vv := v.(type) {
+	case *Table:
+		return ""
+	case error:
+		return vv.Error()
 	case fmt.Stringer:
 		return vv.String()
+	case encoding.TextMarshaler:
+		if text, err := vv.MarshalText(); err == nil {
+			return string(text)
+		}
+	case driver.Valuer:
+		if value, err := vv.Value(); err == nil {
+			return renderValue(value)
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		return renderValue(rv.Elem().Interface())
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', 2, rv.Type().Bits())
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(rv.Complex(), 'f', 2, rv.Type().Bits())
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return renderValue(rv.Bytes())
+		}
 	}
 	return fmt.Sprintf("%v", v)
 }
@@ -3,7 +3,10 @@
 package termtables
 
 import (
+	"errors"
+	"net"
 	"testing"
+	"time"
 )
 
 func TestCellRenderString(t *testing.T) {
@@ -87,6 +90,55 @@ func TestCellRenderGeneric(t *testing.T) {
 	}
 }
 
+type fooInt int
+
+type fooMarshaler struct{}
+
+func (fooMarshaler) MarshalText() ([]byte, error) {
+	return []byte("marshaled"), nil
+}
+
+func TestRenderValue(t *testing.T) {
+	n := 42
+	var nilFoo *foo
+	var nilInt *int
+	when := time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		in  interface{}
+		out string
+	}{
+		{nil, ""},
+		{nilInt, ""},
+		{nilFoo, ""},
+		{&n, "42"},
+		{int8(-8), "-8"},
+		{int16(16), "16"},
+		{int32(-32), "-32"},
+		{uint(7), "7"},
+		{uint8(8), "8"},
+		{uint16(16), "16"},
+		{uint32(32), "32"},
+		{uintptr(64), "64"},
+		{float32(1.5), "1.50"},
+		{float64(2.345), "2.35"},
+		{complex(1, -2), "(1.00-2.00i)"},
+		{fooInt(3), "3"},
+		{[]byte("text"), "text"},
+		{[]byte{0xff, 0x00}, "0xff00"},
+		{errors.New("failed"), "failed"},
+		{when, "2026-10-19T12:30:00Z"},
+		{90 * time.Second, "1m30s"},
+		{fooMarshaler{}, "marshaled"},
+		{net.IPv4(10, 0, 0, 1), "10.0.0.1"},
+		{[]int{1, 2}, "[1 2]"},
+	}
+	for _, test := range tests {
+		if got := renderValue(test.in); got != test.out {
+			t.Errorf("renderValue(%#v) = %q, want %q", test.in, got, test.out)
+		}
+	}
+}
+
 func TestFilterColorCodes(t *testing.T) {
 	tests := []struct {
 		in  string
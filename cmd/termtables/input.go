@@ -0,0 +1,349 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// records is tabular data as read from input, before it becomes a table.
+type records struct {
+	headers []string
+	rows    [][]string
+}
+
+// Input formats, as named by the --input flag.
+const (
+	formatAuto   = "auto"
+	formatCSV    = "csv"
+	formatTSV    = "tsv"
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+	formatText   = "text"
+)
+
+// formatForName returns the input format implied by a file name, or "" if
+// the extension is not one we know.
+func formatForName(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return formatCSV
+	case ".tsv", ".tab":
+		return formatTSV
+	case ".json":
+		return formatJSON
+	case ".ndjson", ".jsonl":
+		return formatNDJSON
+	case ".txt":
+		return formatText
+	}
+	return ""
+}
+
+// sniffFormat guesses the format of some input from its first line.
+func sniffFormat(data []byte) string {
+	trimmed := bytes.TrimLeftFunc(data, unicode.IsSpace)
+	if len(trimmed) == 0 {
+		return formatText
+	}
+	switch trimmed[0] {
+	case '[':
+		return formatJSON
+	case '{':
+		return formatNDJSON
+	}
+	line := trimmed
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+	switch {
+	case bytes.IndexByte(line, '\t') >= 0:
+		return formatTSV
+	case bytes.IndexByte(line, ',') >= 0:
+		return formatCSV
+	}
+	return formatText
+}
+
+// readRecords reads all of r in the given format; if headers is true, the
+// first record of delimited or text input is taken as the headers.  JSON
+// objects always supply headers, from their keys.
+func readRecords(r io.Reader, format string, headers bool) (*records, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if format == formatAuto {
+		format = sniffFormat(data)
+	}
+
+	var rec *records
+	switch format {
+	case formatCSV:
+		rec, err = readDelimited(data, ',')
+	case formatTSV:
+		rec, err = readDelimited(data, '\t')
+	case formatJSON:
+		rec, err = readJSON(data)
+		headers = false
+	case formatNDJSON:
+		rec, err = readNDJSON(data)
+		headers = false
+	case formatText:
+		rec = readText(data)
+	default:
+		return nil, fmt.Errorf("unknown input format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if headers && len(rec.rows) > 0 {
+		rec.headers, rec.rows = rec.rows[0], rec.rows[1:]
+	}
+	return rec, nil
+}
+
+// readDelimited reads CSV, or TSV if comma is '\t', as termtables.ParseCSV
+// does: records may differ in length, and quotes in TSV are taken literally.
+func readDelimited(data []byte, comma rune) (*records, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	if comma == '\t' {
+		reader.LazyQuotes = true
+	}
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return &records{rows: rows}, nil
+}
+
+// readJSON reads a JSON array, of objects or of arrays.
+func readJSON(data []byte) (*records, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('[') {
+		return nil, errors.New("JSON input is not an array")
+	}
+	b := newRecordBuilder()
+	for dec.More() {
+		if err := b.decode(dec); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return b.records(), nil
+}
+
+// readNDJSON reads newline-delimited JSON, with one object or array on each
+// line.
+func readNDJSON(data []byte) (*records, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	b := newRecordBuilder()
+	for dec.More() {
+		if err := b.decode(dec); err != nil {
+			return nil, err
+		}
+	}
+	return b.records(), nil
+}
+
+// recordBuilder collects JSON values as rows, with a column for each key
+// seen in any object, in the order in which keys are first seen.
+type recordBuilder struct {
+	keys    []string
+	columns map[string]int
+	rows    [][]string
+}
+
+func newRecordBuilder() *recordBuilder {
+	return &recordBuilder{columns: map[string]int{}}
+}
+
+// decode reads one value, an object or an array, as a row.
+func (b *recordBuilder) decode(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	row := []string{}
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key := tok.(string)
+			var value interface{}
+			if err := dec.Decode(&value); err != nil {
+				return err
+			}
+			i, ok := b.columns[key]
+			if !ok {
+				i = len(b.keys)
+				b.columns[key] = i
+				b.keys = append(b.keys, key)
+			}
+			for len(row) <= i {
+				row = append(row, "")
+			}
+			row[i] = jsonText(value)
+		}
+	case json.Delim('['):
+		for dec.More() {
+			var value interface{}
+			if err := dec.Decode(&value); err != nil {
+				return err
+			}
+			row = append(row, jsonText(value))
+		}
+	default:
+		return fmt.Errorf("JSON value is not an object or array: %v", tok)
+	}
+	// The closing delimiter.
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	b.rows = append(b.rows, row)
+	return nil
+}
+
+// records returns what was collected, with headers if any objects were read;
+// rows from objects lacking some keys are filled out with empty cells.
+func (b *recordBuilder) records() *records {
+	for i, row := range b.rows {
+		for len(row) < len(b.keys) {
+			row = append(row, "")
+		}
+		b.rows[i] = row
+	}
+	return &records{headers: b.keys, rows: b.rows}
+}
+
+// jsonText returns the text for a cell holding a decoded JSON value; strings
+// and numbers are shown as they are, null as nothing, and objects and arrays
+// as compact JSON.
+func jsonText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
+
+// readText reads whitespace-aligned text, such as the output of ps(1), which
+// is split into columns at the display columns which are blank on every
+// line; blank lines are ignored.  Blanks past the end of the first line, the
+// headers, are not taken as splits, so that the last column can hold words
+// separated by spaces.
+func readText(data []byte) *records {
+	var lines [][]rune
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRightFunc(expandTabs(scanner.Text()), unicode.IsSpace)
+		if line != "" {
+			lines = append(lines, []rune(line))
+		}
+	}
+
+	// Map each line to display cells, and find which are blank in all.
+	width := 0
+	cells := make([][]rune, len(lines))
+	for i, line := range lines {
+		for _, r := range line {
+			cells[i] = append(cells[i], r)
+			for w := runewidth.RuneWidth(r); w > 1; w-- {
+				cells[i] = append(cells[i], 0)
+			}
+		}
+		if len(cells[i]) > width {
+			width = len(cells[i])
+		}
+	}
+	blank := make([]bool, width)
+	for c := range blank {
+		if len(cells) > 0 && c >= len(cells[0]) {
+			break
+		}
+		blank[c] = true
+		for _, line := range cells {
+			if c < len(line) && line[c] != ' ' {
+				blank[c] = false
+				break
+			}
+		}
+	}
+
+	rec := &records{}
+	for _, line := range cells {
+		row := []string{}
+		var field []rune
+		for c := 0; c < width; c++ {
+			if blank[c] {
+				if c > 0 && !blank[c-1] {
+					row = append(row, strings.TrimSpace(string(field)))
+					field = nil
+				}
+				continue
+			}
+			if c < len(line) && line[c] != 0 {
+				field = append(field, line[c])
+			}
+		}
+		if width > 0 && !blank[width-1] {
+			row = append(row, strings.TrimSpace(string(field)))
+		}
+		rec.rows = append(rec.rows, row)
+	}
+	return rec
+}
+
+// expandTabs replaces tabs with spaces, to the next multiple of 8 columns.
+func expandTabs(s string) string {
+	if !strings.ContainsRune(s, '\t') {
+		return s
+	}
+	var b strings.Builder
+	column := 0
+	for _, r := range s {
+		if r == '\t' {
+			n := 8 - column%8
+			b.WriteString(strings.Repeat(" ", n))
+			column += n
+			continue
+		}
+		b.WriteRune(r)
+		column += runewidth.RuneWidth(r)
+	}
+	return b.String()
+}
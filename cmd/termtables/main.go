@@ -0,0 +1,365 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+// Command termtables formats tabular text as a table, for shell scripts
+// which want the same output as Go programs using the termtables package.
+//
+// Usage:
+//
+//	termtables [flags] [file ...]
+//
+// Input is read from the files named, or from standard input, as CSV, TSV,
+// a JSON array of objects or arrays, newline-delimited JSON, or text aligned
+// in columns with spaces, such as the output of ps(1); the format is taken
+// from the file name or guessed from the content unless given with -input.
+// The first record of delimited and text input is the headers, unless
+// -no-headers is given; JSON objects give headers from their keys.
+//
+// The table is drawn for the terminal by default, or written as Markdown,
+// HTML or CSV with -markdown, -html or -csv.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/scylladb/termtables"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// options holds the command-line flags.
+type options struct {
+	input     string
+	noHeaders bool
+	headers   string
+	columns   string
+	align     string
+	sortBy    string
+	title     string
+	width     int
+	fit       string
+	markdown  bool
+	html      bool
+	csv       bool
+	ascii     bool
+	utf8      bool
+}
+
+// run runs the command with the given arguments, returning the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var opts options
+	fs := flag.NewFlagSet("termtables", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.input, "input", formatAuto, "input `format`: auto, csv, tsv, json, ndjson or text")
+	fs.BoolVar(&opts.noHeaders, "no-headers", false, "the input has no header record")
+	fs.StringVar(&opts.headers, "headers", "", "comma-separated `names` to use as headers")
+	fs.StringVar(&opts.columns, "columns", "", "comma-separated `columns` to show, by name or number")
+	fs.StringVar(&opts.align, "align", "", "comma-separated `alignments` (left, right, center, decimal or auto) for each column, or column=alignment")
+	fs.StringVar(&opts.sortBy, "sort", "", "sort rows by this `column`, descending if prefixed with -")
+	fs.StringVar(&opts.title, "title", "", "table `title`")
+	fs.IntVar(&opts.width, "width", 0, "maximum width in `columns`; 0 for the terminal width")
+	fs.StringVar(&opts.fit, "fit", "none", "what to do with a table which is too wide: none, drop, split or vertical")
+	fs.BoolVar(&opts.markdown, "markdown", false, "output a Markdown table")
+	fs.BoolVar(&opts.html, "html", false, "output an HTML table")
+	fs.BoolVar(&opts.csv, "csv", false, "output CSV")
+	fs.BoolVar(&opts.ascii, "ascii", false, "draw with ASCII characters")
+	fs.BoolVar(&opts.utf8, "utf8", false, "draw with UTF-8 box-drawing characters")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: termtables [flags] [file ...]\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+
+	if opts.ascii && opts.utf8 {
+		fmt.Fprintf(stderr, "termtables: -ascii and -utf8 are mutually exclusive\n")
+		return 2
+	}
+	if countTrue(opts.markdown, opts.html, opts.csv) > 1 {
+		fmt.Fprintf(stderr, "termtables: -markdown, -html and -csv are mutually exclusive\n")
+		return 2
+	}
+
+	if err := format(fs.Args(), stdin, stdout, &opts); err != nil {
+		fmt.Fprintf(stderr, "termtables: %s\n", err)
+		return 1
+	}
+	return 0
+}
+
+// format reads the input files, or stdin if there are none, and writes the
+// table to stdout.
+func format(files []string, stdin io.Reader, stdout io.Writer, opts *options) error {
+	rec, err := readInputs(files, stdin, opts)
+	if err != nil {
+		return err
+	}
+	if opts.headers != "" {
+		rec.headers = splitList(opts.headers)
+	}
+
+	table, err := buildTable(rec, opts)
+	if err != nil {
+		return err
+	}
+	return writeTable(table, stdout)
+}
+
+// readInputs reads and concatenates the rows of all the inputs; the headers
+// are those of the first.
+func readInputs(files []string, stdin io.Reader, opts *options) (*records, error) {
+	if len(files) == 0 {
+		return readRecords(stdin, opts.input, !opts.noHeaders)
+	}
+	all := &records{}
+	for i, name := range files {
+		format := opts.input
+		if format == formatAuto {
+			if f := formatForName(name); f != "" {
+				format = f
+			}
+		}
+		rec, err := readInput(name, stdin, format, !opts.noHeaders)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			all.headers = rec.headers
+		}
+		all.rows = append(all.rows, rec.rows...)
+	}
+	return all, nil
+}
+
+// readInput reads the named file, or stdin if the name is "-", closing the
+// file once it has been read.
+func readInput(name string, stdin io.Reader, format string, headers bool) (*records, error) {
+	r := stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	rec, err := readRecords(r, format, headers)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	return rec, nil
+}
+
+// countTrue returns how many of values are true.
+func countTrue(values ...bool) int {
+	n := 0
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+	return n
+}
+
+// splitList splits a comma-separated flag value.
+func splitList(s string) []string {
+	items := strings.Split(s, ",")
+	for i := range items {
+		items[i] = strings.TrimSpace(items[i])
+	}
+	return items
+}
+
+// column returns the index of the column given by header name, or by number
+// counting from 1.
+func (r *records) column(name string) (int, error) {
+	for i, h := range r.headers {
+		if h == name {
+			return i, nil
+		}
+	}
+	for i, h := range r.headers {
+		if strings.EqualFold(h, name) {
+			return i, nil
+		}
+	}
+	if n, err := strconv.Atoi(name); err == nil && n >= 1 && n <= r.width() {
+		return n - 1, nil
+	}
+	return 0, fmt.Errorf("no column %q", name)
+}
+
+// headedColumn returns the index of a column, as column does, and its header,
+// by which the table sorts or shows it.
+func (r *records) headedColumn(name string) (int, string, error) {
+	column, err := r.column(name)
+	if err != nil {
+		return 0, "", err
+	}
+	if column >= len(r.headers) {
+		return 0, "", fmt.Errorf("column %q has no header", name)
+	}
+	return column, r.headers[column], nil
+}
+
+// width returns the number of columns.
+func (r *records) width() int {
+	n := len(r.headers)
+	for _, row := range r.rows {
+		if len(row) > n {
+			n = len(row)
+		}
+	}
+	return n
+}
+
+// buildTable creates the table for the records.
+func buildTable(rec *records, opts *options) (*termtables.Table, error) {
+	table := termtables.CreateTable()
+	style := *table.Style
+	table.Style = &style
+	if opts.title != "" {
+		table.AddTitle(opts.title)
+	}
+	if rec.headers != nil {
+		table.AddHeaders(stringItems(rec.headers)...)
+	}
+	for _, row := range rec.rows {
+		table.AddRow(stringItems(row)...)
+	}
+
+	if opts.sortBy != "" {
+		name, descending := opts.sortBy, false
+		if strings.HasPrefix(name, "-") {
+			name, descending = name[1:], true
+		}
+		_, header, err := rec.headedColumn(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := table.SortBy(header, descending); err != nil {
+			return nil, err
+		}
+	}
+
+	// shown holds the columns given by -columns, to which alignments given
+	// by position apply.
+	var shown []int
+	if opts.columns != "" {
+		headers := []string{}
+		for _, name := range splitList(opts.columns) {
+			column, header, err := rec.headedColumn(name)
+			if err != nil {
+				return nil, err
+			}
+			shown = append(shown, column)
+			headers = append(headers, header)
+		}
+		if err := table.ShowColumns(headers...); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.align != "" {
+		for i, spec := range splitList(opts.align) {
+			column := i
+			if shown != nil {
+				if i >= len(shown) {
+					break
+				}
+				column = shown[i]
+			}
+			if eq := strings.LastIndexByte(spec, '='); eq >= 0 {
+				var err error
+				if column, err = rec.column(spec[:eq]); err != nil {
+					return nil, err
+				}
+				spec = spec[eq+1:]
+			}
+			if spec == "" {
+				continue
+			}
+			align, err := parseAlignment(spec)
+			if err != nil {
+				return nil, err
+			}
+			table.SetAlign(align, column+1)
+		}
+	}
+
+	switch opts.fit {
+	case "none":
+	case "drop":
+		table.SetDropColumns(true)
+		table.SetHiddenColumnsNote(true)
+	case "split":
+		table.SetSplitColumns(true)
+	case "vertical":
+		table.SetExpanded(termtables.ExpandedAuto)
+	default:
+		return nil, fmt.Errorf("unknown -fit %q", opts.fit)
+	}
+	if opts.width > 0 {
+		width := opts.width
+		table.SetSizeProvider(func() int { return width })
+	}
+
+	flags := termtables.OutputFlags{
+		Format:   termtables.FormatTable,
+		Markdown: opts.markdown,
+		HTML:     opts.html,
+		ASCII:    opts.ascii,
+		UTF8:     opts.utf8,
+	}
+	if opts.csv {
+		flags.Format = termtables.FormatCSV
+	}
+	if err := flags.Apply(table); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// stringItems converts strings to the items of a row.
+func stringItems(values []string) []interface{} {
+	items := make([]interface{}, len(values))
+	for i := range values {
+		items[i] = values[i]
+	}
+	return items
+}
+
+// parseAlignment parses an alignment name, or its initial.
+func parseAlignment(name string) (termtables.TableAlignment, error) {
+	switch strings.ToLower(name) {
+	case "l", "left":
+		return termtables.AlignLeft, nil
+	case "r", "right":
+		return termtables.AlignRight, nil
+	case "c", "center", "centre":
+		return termtables.AlignCenter, nil
+	case "d", "decimal":
+		return termtables.AlignDecimal, nil
+	case "auto":
+		return termtables.AlignAuto, nil
+	}
+	return 0, fmt.Errorf("unknown alignment %q", name)
+}
+
+// writeTable writes the table to w; the layout, colors and box-drawing
+// characters suit w, per Table.Fprint, but for those given with -ascii or
+// -utf8.
+func writeTable(table *termtables.Table, w io.Writer) error {
+	_, err := table.Fprint(w)
+	return err
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func checkRun(t *testing.T, args []string, input, expected string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	if status := run(args, strings.NewReader(input), &stdout, &stderr); status != 0 {
+		t.Fatalf("run(%q) = %d: %s", args, status, stderr.String())
+	}
+	if output := stdout.String(); output != expected {
+		t.Fatalf("run(%q):\n\nActual:\n\n%s\nExpected:\n\n%s", args, output, expected)
+	}
+}
+
+func TestInputFormats(t *testing.T) {
+	expected := "" +
+		"+------+------+\n" +
+		"| Name | Size |\n" +
+		"+------+------+\n" +
+		"| a    | 10   |\n" +
+		"| b c  | 2    |\n" +
+		"+------+------+\n"
+
+	inputs := map[string]string{
+		"csv":    "Name,Size\na,10\n\"b c\",2\n",
+		"tsv":    "Name\tSize\na\t10\nb c\t2\n",
+		"json":   `[{"Name": "a", "Size": 10}, {"Name": "b c", "Size": 2}]`,
+		"ndjson": "{\"Name\": \"a\", \"Size\": 10}\n{\"Name\": \"b c\", \"Size\": 2}\n",
+		"text":   "Name  Size\na       10\nb c      2\n",
+	}
+	for format, input := range inputs {
+		checkRun(t, []string{"-input", format}, input, expected)
+		if format != "text" {
+			// Otherwise text would be guessed as CSV, for its comma.
+			checkRun(t, nil, input, expected)
+		}
+	}
+
+	// Arrays in JSON have no headers, and keys missing from some objects
+	// leave cells empty.
+	checkRun(t, nil, `[["a", 1], ["b", [2, 3]]]`, ""+
+		"+---+-------+\n"+
+		"| a | 1     |\n"+
+		"| b | [2,3] |\n"+
+		"+---+-------+\n")
+	checkRun(t, nil, "{\"a\": 1}\n{\"b\": null, \"a\": true}\n{\"b\": \"x\"}\n", ""+
+		"+------+---+\n"+
+		"| a    | b |\n"+
+		"+------+---+\n"+
+		"| 1    |   |\n"+
+		"| true |   |\n"+
+		"|      | x |\n"+
+		"+------+---+\n")
+}
+
+func TestOptions(t *testing.T) {
+	input := "Name,Size,Owner\nalpha,10,root\nbeta,9,nobody\ngamma,100,root\n"
+
+	checkRun(t, []string{"-sort", "-size", "-columns", "Size,Name", "-align", "r", "-title", "Files"}, input, ""+
+		"+--------------+\n"+
+		"|    Files     |\n"+
+		"+------+-------+\n"+
+		"| Size | Name  |\n"+
+		"+------+-------+\n"+
+		"|  100 | gamma |\n"+
+		"|   10 | alpha |\n"+
+		"|    9 | beta  |\n"+
+		"+------+-------+\n")
+	checkRun(t, []string{"-no-headers", "-sort", "2", "-headers", "File,Size,Owner", "-columns", "File,3", "-markdown"}, input, ""+
+		"| File  | Owner  |\n"+
+		"| ----- | ------ |\n"+
+		"| beta  | nobody |\n"+
+		"| alpha | root   |\n"+
+		"| gamma | root   |\n"+
+		"| Name  | Owner  |\n")
+	checkRun(t, []string{"-utf8", "-align", "Size=c"}, input, ""+
+		"╭───────┬──────┬────────╮\n"+
+		"│ Name  │ Size │ Owner  │\n"+
+		"├───────┼──────┼────────┤\n"+
+		"│ alpha │  10  │ root   │\n"+
+		"│ beta  │  9   │ nobody │\n"+
+		"│ gamma │ 100  │ root   │\n"+
+		"╰───────┴──────┴────────╯\n")
+	checkRun(t, []string{"-width", "20", "-fit", "vertical", "-html"}, input, ""+
+		"<table class=\"termtable\">\n"+
+		"<thead>\n"+
+		"<tr><th>Name</th><th>Size</th><th>Owner</th></tr>\n"+
+		"</thead>\n"+
+		"<tbody>\n"+
+		"<tr><td>alpha</td><td>10</td><td>root</td></tr>\n"+
+		"<tr><td>beta</td><td>9</td><td>nobody</td></tr>\n"+
+		"<tr><td>gamma</td><td>100</td><td>root</td></tr>\n"+
+		"</tbody>\n"+
+		"</table>\n")
+	checkRun(t, []string{"-width", "20", "-fit", "drop"}, input, ""+
+		"+-------+------+\n"+
+		"| Name  | Size |\n"+
+		"+-------+------+\n"+
+		"| alpha | 10   |\n"+
+		"| beta  | 9    |\n"+
+		"| gamma | 100  |\n"+
+		"+-------+------+\n"+
+		"+1 column hidden\n")
+	checkRun(t, []string{"-width", "20", "-fit", "vertical", "-ascii"}, input, ""+
+		"+-------+--------+\n"+
+		"| Name  | alpha  |\n"+
+		"| Size  | 10     |\n"+
+		"| Owner | root   |\n"+
+		"+-------+--------+\n"+
+		"| Name  | beta   |\n"+
+		"| Size  | 9      |\n"+
+		"| Owner | nobody |\n"+
+		"+-------+--------+\n"+
+		"| Name  | gamma  |\n"+
+		"| Size  | 100    |\n"+
+		"| Owner | root   |\n"+
+		"+-------+--------+\n")
+
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"-columns", "Missing"}, strings.NewReader(input), &stdout, &stderr); status != 1 {
+		t.Errorf("unknown column: status %d, want 1", status)
+	}
+	if msg, want := stderr.String(), "termtables: no column \"Missing\"\n"; msg != want {
+		t.Errorf("unknown column: got %q, want %q", msg, want)
+	}
+	if status := run([]string{"-ascii", "-utf8"}, strings.NewReader(input), &stdout, &stderr); status != 2 {
+		t.Errorf("-ascii -utf8: status %d, want 2", status)
+	}
+	stderr.Reset()
+	if status := run([]string{"-no-headers", "-sort", "2"}, strings.NewReader(input), &stdout, &stderr); status != 1 {
+		t.Errorf("sort without headers: status %d, want 1", status)
+	}
+	if msg, want := stderr.String(), "termtables: column \"2\" has no header\n"; msg != want {
+		t.Errorf("sort without headers: got %q, want %q", msg, want)
+	}
+	for _, args := range [][]string{{"-markdown", "-html"}, {"-html", "-csv"}, {"-csv", "-markdown"}} {
+		if status := run(args, strings.NewReader(input), &stdout, &stderr); status != 2 {
+			t.Errorf("%s: status %d, want 2", strings.Join(args, " "), status)
+		}
+	}
+}
+
+func TestDelimitedKeepsText(t *testing.T) {
+	checkRun(t, []string{"-html"}, "Name,Load\n\033[31malpha\033[0m,1.50\n", ""+
+		"<table class=\"termtable\">\n"+
+		"<thead>\n"+
+		"<tr><th>Name</th><th>Load</th></tr>\n"+
+		"</thead>\n"+
+		"<tbody>\n"+
+		"<tr><td><span style=\"color: #cd0000\">alpha</span></td><td>1.50</td></tr>\n"+
+		"</tbody>\n"+
+		"</table>\n")
+}
+
+func TestReadText(t *testing.T) {
+	input := "" +
+		"  PID TTY          TIME CMD\n" +
+		"    1 ?        00:00:02 init splash\n" +
+		"\n" +
+		" 4211 pts/0    00:00:00 bash\n"
+	rec := readText([]byte(input))
+	expected := [][]string{
+		{"PID", "TTY", "TIME", "CMD"},
+		{"1", "?", "00:00:02", "init splash"},
+		{"4211", "pts/0", "00:00:00", "bash"},
+	}
+	if len(rec.rows) != len(expected) {
+		t.Fatalf("got %d rows, want %d: %q", len(rec.rows), len(expected), rec.rows)
+	}
+	for i := range expected {
+		if strings.Join(rec.rows[i], "|") != strings.Join(expected[i], "|") {
+			t.Errorf("row %d: got %q, want %q", i, rec.rows[i], expected[i])
+		}
+	}
+}
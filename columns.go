@@ -0,0 +1,250 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"fmt"
+)
+
+// HideColumns hides the named columns, matched against the headers of the
+// table, in all output modes; it returns an error, and hides nothing, if any
+// name does not match a header.
+func (t *Table) HideColumns(names ...string) error {
+	indices, err := t.columnIndices(names)
+	if err != nil {
+		return err
+	}
+	if t.hiddenColumns == nil {
+		t.hiddenColumns = map[int]bool{}
+	}
+	for _, i := range indices {
+		t.hiddenColumns[i] = true
+	}
+	return nil
+}
+
+// ShowColumns restricts the table to the named columns, matched against the
+// headers of the table, in the order named, in all output modes; this suits
+// implementing a --columns flag.  It returns an error, and changes nothing,
+// if any name does not match a header.  Calling ShowColumns with no names
+// shows all columns again, and also undoes HideColumns.
+func (t *Table) ShowColumns(names ...string) error {
+	if len(names) == 0 {
+		t.columnOrder = nil
+		t.hiddenColumns = nil
+		return nil
+	}
+	indices, err := t.columnIndices(names)
+	if err != nil {
+		return err
+	}
+	t.columnOrder = indices
+	return nil
+}
+
+// SetColumnPriority sets the priority of columns, numbered from 1, for
+// dropping columns when the table is too wide; see SetDropColumns.  Columns
+// default to priority 0.
+func (t *Table) SetColumnPriority(priority int, columns ...int) {
+	if t.columnPriority == nil {
+		t.columnPriority = map[int]int{}
+	}
+	for _, column := range columns {
+		if column > 0 {
+			t.columnPriority[column-1] = priority
+		}
+	}
+}
+
+// SetDropColumns controls whether columns are dropped, in terminal mode, when
+// the table would be wider than the available columns (see SetSizeProvider):
+// the column with the lowest priority, and the rightmost of those with equal
+// priority, is dropped until the table fits or only one column remains.
+func (t *Table) SetDropColumns(onoff bool) {
+	t.dropColumns = onoff
+}
+
+// SetHiddenColumnsNote controls whether a note such as "+2 columns hidden" is
+// added below a table from which columns were dropped to fit.
+func (t *Table) SetHiddenColumnsNote(onoff bool) {
+	t.hiddenColumnsNote = onoff
+}
+
+// headerName returns the text of a header, as used to match column names.
+func headerName(h interface{}) string {
+	if c, ok := h.(*Cell); ok {
+		return filterColorCodes(c.formattedValue)
+	}
+	return filterColorCodes(renderValue(h))
+}
+
+// columnIndices returns the indices of the columns with the given headers.
+func (t *Table) columnIndices(names []string) ([]int, error) {
+	indices := make([]int, 0, len(names))
+	for _, name := range names {
+		found := false
+		for i, h := range t.headers {
+			if headerName(h) == name {
+				indices = append(indices, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("termtables: no column %q", name)
+		}
+	}
+	return indices, nil
+}
+
+// columnCount returns the number of cells in the longest row or headers.
+func (t *Table) columnCount() int {
+	n := len(t.headers)
+	for _, e := range t.elements {
+		if row, ok := e.(*Row); ok && len(row.cells) > n {
+			n = len(row.cells)
+		}
+	}
+	return n
+}
+
+// allColumns returns the indices of all columns, in order.
+func (t *Table) allColumns() []int {
+	columns := make([]int, t.columnCount())
+	for i := range columns {
+		columns[i] = i
+	}
+	return columns
+}
+
+// visibleColumns returns the indices of the columns to be shown, in order,
+// or nil if all columns are shown in their original order.
+func (t *Table) visibleColumns() []int {
+	if t.columnOrder == nil && len(t.hiddenColumns) == 0 {
+		return nil
+	}
+	columns := t.columnOrder
+	if columns == nil {
+		columns = t.allColumns()
+	}
+	visible := make([]int, 0, len(columns))
+	for _, i := range columns {
+		if !t.hiddenColumns[i] {
+			visible = append(visible, i)
+		}
+	}
+	return visible
+}
+
+// withVisibleColumns returns the table restricted to its visible columns; if
+// all are visible, the table itself is returned.
+func (t *Table) withVisibleColumns() *Table {
+	return t.projectColumns(t.visibleColumns())
+}
+
+// dropLowestPriority returns columns without the lowest-priority column.
+func (t *Table) dropLowestPriority(columns []int) []int {
+	drop := len(columns) - 1
+	for i := len(columns) - 1; i >= 0; i-- {
+		if t.columnPriority[columns[i]] < t.columnPriority[columns[drop]] {
+			drop = i
+		}
+	}
+	return append(append([]int{}, columns[:drop]...), columns[drop+1:]...)
+}
+
+// projectColumns returns a copy of the table holding only the given columns,
+// in the given order; if columns is nil, the table itself is returned.  The
+// cells are copied, as their position in the row is stored in them.
+func (t *Table) projectColumns(columns []int) *Table {
+	if columns == nil {
+		return t
+	}
+	tt := t.clone()
+	tt.columnOrder, tt.hiddenColumns = nil, nil
+	if t.minWidths != nil {
+		tt.minWidths = map[int]int{}
+		for j, i := range columns {
+			tt.minWidths[j] = t.minWidths[i]
+		}
+	}
+	if t.columnNumberFormats != nil {
+		tt.columnNumberFormats = map[int]*NumberFormat{}
+		for j, i := range columns {
+			if f, ok := t.columnNumberFormats[i]; ok {
+				tt.columnNumberFormats[j] = f
+			}
+		}
+	}
+	if t.columnPlaceholders != nil {
+		tt.columnPlaceholders = map[int]string{}
+		for j, i := range columns {
+			if p, ok := t.columnPlaceholders[i]; ok {
+				tt.columnPlaceholders[j] = p
+			}
+		}
+	}
+	if t.headers != nil {
+		tt.headers = make([]interface{}, 0, len(columns))
+		for _, i := range columns {
+			if i < len(t.headers) {
+				tt.headers = append(tt.headers, t.headers[i])
+			} else {
+				tt.headers = append(tt.headers, "")
+			}
+		}
+	}
+	for n, e := range tt.elements {
+		row, ok := e.(*Row)
+		if !ok || row.groupHeader {
+			continue
+		}
+		tt.elements[n] = projectRow(row, columns)
+	}
+	return tt
+}
+
+// projectRow returns a copy of a row holding only the given columns, in the
+// given order.  A cell spanning several columns spans those of them which
+// are kept and next to each other, and is left out if none are kept.
+func projectRow(row *Row, columns []int) *Row {
+	cover := map[int]int{}
+	for k, start := range row.cellColumns() {
+		span := row.cells[k].colSpan
+		if span < 1 {
+			span = 1
+		}
+		for _, i := range columns {
+			if i >= start && i < start+span {
+				cover[i] = k
+			}
+		}
+	}
+
+	last := -1
+	for j, i := range columns {
+		if _, ok := cover[i]; ok {
+			last = j
+		}
+	}
+	projected := &Row{cells: []*Cell{}, class: row.class, subtotal: row.subtotal, level: row.level}
+	for j := 0; j <= last; j++ {
+		k, ok := cover[columns[j]]
+		if !ok {
+			projected.AddCell("")
+			continue
+		}
+		span := 1
+		for j+span <= last {
+			if next, ok := cover[columns[j+span]]; !ok || next != k {
+				break
+			}
+			span++
+		}
+		c := *row.cells[k]
+		c.colSpan = span
+		projected.AddCell(&c)
+		j += span - 1
+	}
+	return projected
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func createColumnsTestTable() *Table {
+	table := CreateTable()
+	table.AddHeaders("Address", "DC", "Rack", "Load", "Tokens")
+	table.AddRow("10.0.0.1", "dc1", "rack1", "1.5 GB", 256)
+	table.AddRow("10.0.0.2", "dc2", "rack2", "12 KB", 256)
+	return table
+}
+
+func TestShowAndHideColumns(t *testing.T) {
+	table := createColumnsTestTable()
+	if err := table.ShowColumns("Load", "Address", "Rack"); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.HideColumns("Rack"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "" +
+		"+--------+----------+\n" +
+		"| Load   | Address  |\n" +
+		"+--------+----------+\n" +
+		"| 1.5 GB | 10.0.0.1 |\n" +
+		"| 12 KB  | 10.0.0.2 |\n" +
+		"+--------+----------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeCSV()
+	checkRendersTo(t, table, "Load,Address\n1.5 GB,10.0.0.1\n12 KB,10.0.0.2\n")
+
+	if err := table.HideColumns("Nope"); err == nil {
+		t.Fatal("Expected an error for an unknown column")
+	}
+	table.ShowColumns()
+	checkRendersTo(t, table, "Address,DC,Rack,Load,Tokens\n"+
+		"10.0.0.1,dc1,rack1,1.5 GB,256\n"+
+		"10.0.0.2,dc2,rack2,12 KB,256\n")
+}
+
+func TestDropColumnsByPriority(t *testing.T) {
+	saved := MaxColumns
+	defer func() { MaxColumns = saved }()
+	MaxColumns = 30
+
+	table := createColumnsTestTable()
+	table.SetDropColumns(true)
+	table.SetHiddenColumnsNote(true)
+	table.SetColumnPriority(10, 1)
+	table.SetColumnPriority(5, 4)
+	table.SetColumnPriority(-1, 3)
+
+	expected := "" +
+		"+----------+-----+--------+\n" +
+		"| Address  | DC  | Load   |\n" +
+		"+----------+-----+--------+\n" +
+		"| 10.0.0.1 | dc1 | 1.5 GB |\n" +
+		"| 10.0.0.2 | dc2 | 12 KB  |\n" +
+		"+----------+-----+--------+\n" +
+		"+2 columns hidden\n"
+	checkRendersTo(t, table, expected)
+
+	MaxColumns = 21
+	expected = "" +
+		"+----------+--------+\n" +
+		"| Address  | Load   |\n" +
+		"+----------+--------+\n" +
+		"| 10.0.0.1 | 1.5 GB |\n" +
+		"| 10.0.0.2 | 12 KB  |\n" +
+		"+----------+--------+\n" +
+		"+3 columns hidden\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestHideColumnsWithColSpan(t *testing.T) {
+	table := createColumnsTestTable()
+	table.AddRow("10.0.0.3", "dc1", CreateCell("unreachable", &CellStyle{ColSpan: 3}))
+	if err := table.HideColumns("DC", "Load"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "" +
+		"+----------+-------+--------+\n" +
+		"| Address  | Rack  | Tokens |\n" +
+		"+----------+-------+--------+\n" +
+		"| 10.0.0.1 | rack1 | 256    |\n" +
+		"| 10.0.0.2 | rack2 | 256    |\n" +
+		"| 10.0.0.3 | unreachable    |\n" +
+		"+----------+-------+--------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.ShowColumns()
+	table.HideColumns("DC", "Rack")
+	expected = "" +
+		"+----------+--------+--------+\n" +
+		"| Address  | Load   | Tokens |\n" +
+		"+----------+--------+--------+\n" +
+		"| 10.0.0.1 | 1.5 GB | 256    |\n" +
+		"| 10.0.0.2 | 12 KB  | 256    |\n" +
+		"| 10.0.0.3 | unreachable     |\n" +
+		"+----------+--------+--------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeCSV()
+	checkRendersTo(t, table, "Address,Load,Tokens\n"+
+		"10.0.0.1,1.5 GB,256\n"+
+		"10.0.0.2,12 KB,256\n"+
+		"10.0.0.3,unreachable\n")
+}
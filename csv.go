@@ -0,0 +1,50 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+)
+
+// RenderCSV returns a representation of the table as comma-separated values,
+// per RFC 4180, for consumption by other programs.  The headers, if any, are
+// the first record; the title, separators and rows added by GroupBy are
+// omitted, as CSV has no way to represent them, color escape sequences are
+// removed from cells, and empty values are left empty; see
+// SetEmptyPlaceholder.
+func (t *Table) RenderCSV() string {
+	t = t.withVisibleColumns()
+	b := bytes.NewBuffer(nil)
+	w := csv.NewWriter(b)
+
+	if t.headers != nil {
+		w.Write(t.csvRecord(CreateRow(t.headers)))
+	}
+	for _, e := range t.elements {
+		if isDataRow(e) {
+			w.Write(t.csvRecord(e.(*Row)))
+		}
+	}
+	w.Flush()
+
+	return b.String()
+}
+
+// csvRecord returns the fields of one CSV record for a row; nested tables are
+// drawn as for the terminal, with the settings of t, per nestedTable.
+func (t *Table) csvRecord(r *Row) []string {
+	record := make([]string, len(r.cells))
+	for i, c := range r.cells {
+		if c.empty {
+			continue
+		}
+		if inner, ok := c.value.(*Table); ok {
+			record[i] = filterColorCodes(strings.TrimSuffix(t.nestedTable(inner).renderTerminal(), "\n"))
+			continue
+		}
+		record[i] = filterColorCodes(c.formattedValue)
+	}
+	return record
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import "strings"
+
+// dimStart and dimEnd are the escape sequences around placeholders for empty
+// values, which are dimmed if color is known to be wanted: enabled with
+// SetColor, or found in the capabilities of the output.
+const (
+	dimStart = "\033[2m"
+	dimEnd   = "\033[22m"
+)
+
+// withDisplayValues returns a copy of the table with the cells changed to
+// show values as people are to see them, in terminal, Markdown and HTML
+// output: numbers are formatted in columns with a NumberFormat, and empty
+// values replaced in columns with a placeholder, or nil ones with
+// NilPlaceholder.  Nested tables are drawn, per nestedTable.  Trees of rows
+// are drawn with guides, and collapsed to the tree depth.  The copy has no
+// formats, placeholders or trees set, so that rendering it again changes
+// nothing; if the table has none, it is returned itself.
+func (t *Table) withDisplayValues() *Table {
+	return t.displayValues(true)
+}
+
+// displayValues does the work of withDisplayValues, with the guides of trees
+// of rows left out if not wanted, as in HTML.
+func (t *Table) displayValues(guides bool) *Table {
+	if t.numberFormat == nil && len(t.columnNumberFormats) == 0 &&
+		t.emptyPlaceholder == "" && len(t.columnPlaceholders) == 0 &&
+		NilPlaceholder == "" && !t.tree && !t.hasNestedTables() {
+		return t
+	}
+	tt := t.clone()
+	tt.numberFormat, tt.columnNumberFormats = nil, nil
+	tt.emptyPlaceholder, tt.columnPlaceholders = "", nil
+	for n, e := range tt.elements {
+		row, ok := e.(*Row)
+		if !ok {
+			continue
+		}
+		shown := &Row{cells: make([]*Cell, len(row.cells)), class: row.class,
+			groupHeader: row.groupHeader, subtotal: row.subtotal, level: row.level}
+		columns := row.cellColumns()
+		for i, c := range row.cells {
+			shown.cells[i] = c
+			if inner, ok := c.value.(*Table); ok && inner != nil {
+				sc := *c
+				nested := t.nestedTable(inner)
+				sc.value = nested
+				switch t.outputMode {
+				case outputHTML:
+					// Row.HTML draws the nested table itself.
+				case outputMarkdown:
+					sc.formattedValue = strings.Replace(nested.RenderHTML(), "\n", "", -1)
+				default:
+					sc.formattedValue = strings.TrimSuffix(nested.renderTerminal(), "\n")
+				}
+				shown.cells[i] = &sc
+				continue
+			}
+			if c.empty {
+				placeholder := t.columnPlaceholder(columns[i])
+				if placeholder == "" && isNilValue(c.value) {
+					placeholder = NilPlaceholder
+				}
+				if placeholder != "" {
+					sc := *c
+					sc.formattedValue = placeholder
+					if t.color {
+						sc.formattedValue = dimStart + placeholder + dimEnd
+					}
+					shown.cells[i] = &sc
+				}
+				continue
+			}
+			if f := t.columnNumberFormat(columns[i]); f != nil {
+				if s, decimal, ok := f.format(c.value); ok {
+					sc := *c
+					sc.formattedValue, sc.decimalPoint = s, decimal
+					shown.cells[i] = &sc
+				}
+			}
+		}
+		tt.elements[n] = shown
+	}
+	if t.tree {
+		tt.elements = t.treeElements(tt.elements, guides)
+		tt.tree, tt.treeDepth = false, 0
+	}
+	return tt
+}
+
+// hasNestedTables reports whether any cell of the table holds a table.
+func (t *Table) hasNestedTables() bool {
+	for _, e := range t.elements {
+		row, ok := e.(*Row)
+		if !ok {
+			continue
+		}
+		for _, c := range row.cells {
+			if inner, ok := c.value.(*Table); ok && inner != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nestedTable returns a copy of a table held in a cell of t, to be drawn
+// with the settings of t: its output mode, color, width and representation
+// of colors in HTML, and its box-drawing characters if both tables use the
+// ASCII or UTF-8 ones.
+func (t *Table) nestedTable(inner *Table) *Table {
+	nt := inner.clone()
+	nt.outputMode = t.outputMode
+	nt.noColor = t.noColor
+	nt.color = t.color
+	nt.sizeProvider = t.maxColumns
+	nt.htmlOptions.ANSI = t.htmlOptions.ANSI
+	if !nt.fixedBorders && nt.Style.isStockBoxStyle() && t.Style.isStockBoxStyle() {
+		style := *nt.Style
+		if t.Style.isUTF8Box() {
+			style.setUtfBoxStyle()
+		} else {
+			style.setAsciiBoxStyle()
+		}
+		nt.Style = &style
+	}
+	return nt
+}
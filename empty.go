@@ -0,0 +1,79 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+)
+
+// SetEmptyPlaceholder sets the text, such as "—" or "n/a", shown in place of
+// empty values in all columns of this table, except those given their own
+// with SetColumnEmptyPlaceholder, in terminal, Markdown and HTML output; it
+// is dimmed if color has been enabled with SetColor, or found by
+// SetCapabilities, Fprint or RenderFor.  CSV output leaves empty values
+// empty, and JSON output gives them as null, or as "" for empty strings.
+// Empty values are nil, nil pointers, empty strings, zero times, and values
+// such as sql.NullString which are not Valid.  An empty placeholder shows
+// empty values as they were added.
+func (t *Table) SetEmptyPlaceholder(placeholder string) {
+	t.emptyPlaceholder = placeholder
+}
+
+// SetColumnEmptyPlaceholder sets the placeholder for empty values in the
+// given columns, numbered from 1; an empty placeholder reverts them to that
+// of the table.
+func (t *Table) SetColumnEmptyPlaceholder(placeholder string, columns ...int) {
+	if t.columnPlaceholders == nil {
+		t.columnPlaceholders = map[int]string{}
+	}
+	for _, column := range columns {
+		if placeholder == "" {
+			delete(t.columnPlaceholders, column-1)
+		} else {
+			t.columnPlaceholders[column-1] = placeholder
+		}
+	}
+}
+
+// columnPlaceholder returns the placeholder for empty values in a column, or
+// "" if there is none.
+func (t *Table) columnPlaceholder(column int) string {
+	if p, ok := t.columnPlaceholders[column]; ok {
+		return p
+	}
+	return t.emptyPlaceholder
+}
+
+// isNilValue reports whether a value added to a table is nil, or a nil
+// pointer, for NilPlaceholder.
+func isNilValue(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+// isEmptyValue reports whether a value added to a table is empty, for
+// SetEmptyPlaceholder.
+func isEmptyValue(v interface{}) bool {
+	switch vv := v.(type) {
+	case nil:
+		return true
+	case string:
+		return vv == ""
+	case time.Time:
+		return vv.IsZero()
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return true
+	}
+	if valuer, ok := v.(driver.Valuer); ok {
+		value, err := valuer.Value()
+		return err == nil && value == nil
+	}
+	return false
+}
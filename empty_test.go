@@ -0,0 +1,133 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+)
+
+func createEmptyTable() *Table {
+	var missing *int
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Count", "Seen")
+	table.AddRow("a", sql.NullInt64{Int64: 7, Valid: true}, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	table.AddRow("", sql.NullInt64{}, time.Time{})
+	table.AddRow(nil, missing, sql.NullTime{})
+	return table
+}
+
+func TestEmptyPlaceholder(t *testing.T) {
+	table := createEmptyTable()
+	table.SetEmptyPlaceholder("n/a")
+	table.SetColumnEmptyPlaceholder("—", 3)
+	table.Style.Alignment = AlignAuto
+
+	expected := "" +
+		"+------+-------+----------------------+\n" +
+		"| Name | Count | Seen                 |\n" +
+		"+------+-------+----------------------+\n" +
+		"| a    |     7 | 2026-01-02T03:04:05Z |\n" +
+		"| n/a  |   n/a | —                    |\n" +
+		"| n/a  |   n/a | —                    |\n" +
+		"+------+-------+----------------------+\n"
+	checkRendersTo(t, table, expected)
+	for _, e := range table.withDisplayValues().elements {
+		for _, c := range e.(*Row).cells {
+			if strings.Contains(c.formattedValue, dimStart) {
+				t.Errorf("Placeholder %q dimmed by default", c.formattedValue)
+			}
+		}
+	}
+
+	table.SetColor(true)
+	expected = "" +
+		"+------+-------+----------------------+\n" +
+		"| Name | Count | Seen                 |\n" +
+		"+------+-------+----------------------+\n" +
+		"| a    |     7 | 2026-01-02T03:04:05Z |\n" +
+		"| \033[2mn/a\033[22m  |   \033[2mn/a\033[22m | \033[2m—\033[22m                    |\n" +
+		"| \033[2mn/a\033[22m  |   \033[2mn/a\033[22m | \033[2m—\033[22m                    |\n" +
+		"+------+-------+----------------------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeMarkdown()
+	expected = "" +
+		"| Name | Count | Seen                 |\n" +
+		"| ---- | ----- | -------------------- |\n" +
+		"| a    |     7 | 2026-01-02T03:04:05Z |\n" +
+		"| n/a  |   n/a | —                    |\n" +
+		"| n/a  |   n/a | —                    |\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestEmptyPlaceholderAfterSpan(t *testing.T) {
+	table := CreateTable()
+	table.SetModeHTML()
+	table.SetHTMLOptions(HTMLOptions{Classes: []string{}})
+	table.AddRow("a", "", "")
+	table.AddRow(CreateCell("b", &CellStyle{ColSpan: 2}), "")
+	table.SetColumnEmptyPlaceholder("—", 3)
+
+	expected := "" +
+		"<table>\n" +
+		"<tbody>\n" +
+		"<tr><td>a</td><td></td><td>—</td></tr>\n" +
+		"<tr><td colspan=\"2\">b</td><td>—</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestNilPlaceholder(t *testing.T) {
+	saved := NilPlaceholder
+	defer func() { NilPlaceholder = saved }()
+	NilPlaceholder = "-"
+
+	table := createEmptyTable()
+	table.SetColumnEmptyPlaceholder("n/a", 2)
+	table.SetColor(false)
+	expected := "" +
+		"+------+-------+----------------------+\n" +
+		"| Name | Count | Seen                 |\n" +
+		"+------+-------+----------------------+\n" +
+		"| a    | 7     | 2026-01-02T03:04:05Z |\n" +
+		"|      | n/a   | 0001-01-01T00:00:00Z |\n" +
+		"| -    | n/a   |                      |\n" +
+		"+------+-------+----------------------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeCSV()
+	expected = "" +
+		"Name,Count,Seen\n" +
+		"a,7,2026-01-02T03:04:05Z\n" +
+		",,\n" +
+		",,\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestEmptyValuesForPrograms(t *testing.T) {
+	table := createEmptyTable()
+	table.SetEmptyPlaceholder("n/a")
+
+	table.SetModeCSV()
+	expected := "" +
+		"Name,Count,Seen\n" +
+		"a,7,2026-01-02T03:04:05Z\n" +
+		",,\n" +
+		",,\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeJSON()
+	expected = "" +
+		"[\n" +
+		`{"Name":"a","Count":7,"Seen":"2026-01-02T03:04:05Z"},` + "\n" +
+		`{"Name":"","Count":null,"Seen":null},` + "\n" +
+		`{"Name":null,"Count":null,"Seen":null}` + "\n" +
+		"]\n"
+	checkRendersTo(t, table, expected)
+}
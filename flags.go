@@ -0,0 +1,155 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FlagSet is the part of a set of command-line flags needed by
+// RegisterOutputFlags; both *flag.FlagSet from the standard library and
+// *pflag.FlagSet from github.com/spf13/pflag satisfy it.
+type FlagSet interface {
+	StringVar(p *string, name string, value string, usage string)
+	BoolVar(p *bool, name string, value bool, usage string)
+	IntVar(p *int, name string, value int, usage string)
+}
+
+// Output formats, as given to the --format flag.
+const (
+	FormatTable    = "table"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatCSV      = "csv"
+	FormatJSON     = "json"
+)
+
+// OutputFlags holds the values of the flags registered by
+// RegisterOutputFlags, which Apply applies to a table.
+type OutputFlags struct {
+	// Format is the output format: FormatTable, FormatMarkdown, FormatHTML,
+	// FormatCSV or FormatJSON.
+	Format string
+	// NoHeaders leaves out the headers.
+	NoHeaders bool
+	// Columns is a comma-separated list of the columns to show, by header.
+	Columns string
+	// SortBy is the header of the column to sort rows by, descending if
+	// prefixed with "-".
+	SortBy string
+	// Width is the width to lay tables out for, if greater than 0.
+	Width int
+
+	// Markdown, HTML, ASCII and UTF8 are the shorthand flags used by apc;
+	// the first two select a format, the others the box-drawing characters
+	// of the table format.
+	Markdown bool
+	HTML     bool
+	ASCII    bool
+	UTF8     bool
+}
+
+// RegisterOutputFlags registers flags for choosing how tables are output on
+// fs, returning their values, for Apply once the flags have been parsed:
+//
+//	--format=table|markdown|html|csv|json
+//	--no-headers
+//	--columns=NAME,...
+//	--sort-by=[-]NAME
+//	--width=N
+//
+// The flags --markdown, --html, --ascii and --utf8 are also registered, as
+// used by apc.
+func RegisterOutputFlags(fs FlagSet) *OutputFlags {
+	f := &OutputFlags{}
+	fs.StringVar(&f.Format, "format", FormatTable, "output format: table, markdown, html, csv or json")
+	fs.BoolVar(&f.NoHeaders, "no-headers", false, "leave out the headers")
+	fs.StringVar(&f.Columns, "columns", "", "comma-separated columns to show")
+	fs.StringVar(&f.SortBy, "sort-by", "", "column to sort by, descending if prefixed with -")
+	fs.IntVar(&f.Width, "width", 0, "width to lay tables out for; 0 for the terminal width")
+	fs.BoolVar(&f.Markdown, "markdown", false, "output a Markdown table, as --format=markdown")
+	fs.BoolVar(&f.HTML, "html", false, "output an HTML table, as --format=html")
+	fs.BoolVar(&f.ASCII, "ascii", false, "draw tables with ASCII characters")
+	fs.BoolVar(&f.UTF8, "utf8", false, "draw tables with UTF-8 box-drawing characters")
+	return f
+}
+
+// Apply applies the flags to a table: its output mode, sort order, columns
+// and width are set, its headers removed if so asked, and its style replaced
+// with a copy using the chosen box-drawing characters, which RenderFor and
+// Fprint then keep.  The columns and sort order are matched against the
+// headers.  An error is returned for unknown formats and columns, and for
+// conflicting flags.
+func (f *OutputFlags) Apply(t *Table) error {
+	format := f.Format
+	if f.Markdown || f.HTML {
+		if f.Markdown && f.HTML {
+			return fmt.Errorf("termtables: --markdown and --html conflict")
+		}
+		shorthand := FormatMarkdown
+		if f.HTML {
+			shorthand = FormatHTML
+		}
+		if format != FormatTable && format != "" && format != shorthand {
+			return fmt.Errorf("termtables: --%s conflicts with --format=%s", shorthand, format)
+		}
+		format = shorthand
+	}
+	if f.ASCII && f.UTF8 {
+		return fmt.Errorf("termtables: --ascii and --utf8 conflict")
+	}
+
+	switch format {
+	case FormatTable, "":
+		t.SetModeTerminal()
+	case FormatMarkdown:
+		t.SetModeMarkdown()
+	case FormatHTML:
+		t.SetModeHTML()
+	case FormatCSV:
+		t.SetModeCSV()
+	case FormatJSON:
+		t.SetModeJSON()
+	default:
+		return fmt.Errorf("termtables: unknown format %q", format)
+	}
+
+	if f.SortBy != "" {
+		column, descending := f.SortBy, false
+		if strings.HasPrefix(column, "-") {
+			column, descending = column[1:], true
+		}
+		if err := t.SortBy(column, descending); err != nil {
+			return err
+		}
+	}
+	if f.Columns != "" {
+		names := strings.Split(f.Columns, ",")
+		for i := range names {
+			names[i] = strings.TrimSpace(names[i])
+		}
+		if err := t.ShowColumns(names...); err != nil {
+			return err
+		}
+	}
+	if f.NoHeaders {
+		t.headers = nil
+	}
+
+	if f.Width > 0 {
+		width := f.Width
+		t.SetSizeProvider(func() int { return width })
+	}
+	if f.ASCII || f.UTF8 {
+		style := *t.Style
+		if f.UTF8 {
+			style.setUtfBoxStyle()
+		} else {
+			style.setAsciiBoxStyle()
+		}
+		t.Style = &style
+		t.fixedBorders = true
+	}
+	return nil
+}
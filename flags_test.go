@@ -0,0 +1,93 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+)
+
+func createFlagsTable() *Table {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Size", "Owner")
+	table.AddRow("alpha", 10, "root")
+	table.AddRow("beta", 9, "nobody")
+	return table
+}
+
+// applyFlags parses the arguments with the output flags and applies them.
+func applyFlags(t *testing.T, table *Table, args ...string) error {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	flags := RegisterOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	return flags.Apply(table)
+}
+
+func TestOutputFlags(t *testing.T) {
+	table := createFlagsTable()
+	if err := applyFlags(t, table, "--format=json", "--sort-by=Size", "--columns=Owner,Size"); err != nil {
+		t.Fatal(err)
+	}
+	expected := "" +
+		"[\n" +
+		`{"Owner":"nobody","Size":9},` + "\n" +
+		`{"Owner":"root","Size":10}` + "\n" +
+		"]\n"
+	checkRendersTo(t, table, expected)
+
+	table = createFlagsTable()
+	if err := applyFlags(t, table, "--markdown", "--no-headers", "--sort-by=-Name"); err != nil {
+		t.Fatal(err)
+	}
+	expected = "" +
+		"||\n" +
+		"| ----- | -- | ------ |\n" +
+		"| beta  | 9  | nobody |\n" +
+		"| alpha | 10 | root   |\n"
+	checkRendersTo(t, table, expected)
+
+	// Chosen box-drawing characters are kept when rendering for a file.
+	table = createFlagsTable()
+	if err := applyFlags(t, table, "-utf8", "-width", "20", "-columns", "Name"); err != nil {
+		t.Fatal(err)
+	}
+	expected = "" +
+		"╭───────╮\n" +
+		"│ Name  │\n" +
+		"├───────┤\n" +
+		"│ alpha │\n" +
+		"│ beta  │\n" +
+		"╰───────╯\n"
+	var b bytes.Buffer
+	if _, err := table.Fprint(&b); err != nil {
+		t.Fatal(err)
+	}
+	if output := b.String(); output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+	if DefaultStyle.BorderX != "-" {
+		t.Error("applying --utf8 changed DefaultStyle")
+	}
+}
+
+func TestOutputFlagsErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"--format=yaml"},
+		{"--markdown", "--html"},
+		{"--html", "--format=csv"},
+		{"--ascii", "--utf8"},
+		{"--columns=Name,Missing"},
+		{"--sort-by=-Missing"},
+	} {
+		if err := applyFlags(t, createFlagsTable(), args...); err == nil {
+			t.Errorf("%q: no error", args)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import "sort"
+
+// An Aggregate computes the value of a cell in a subtotal row from the
+// values of the column in the rows of a group; see GroupBy.
+type Aggregate func(values []interface{}) interface{}
+
+// A Subtotal asks GroupBy for a subtotal in the named column, computed by an
+// Aggregate such as AggregateSum.
+type Subtotal struct {
+	Column    string
+	Aggregate Aggregate
+}
+
+// SubtotalLabel is shown in the key column of subtotal rows made by
+// GroupBy, unless a Subtotal is given for that column.
+const SubtotalLabel = "Subtotal"
+
+// GroupBy groups the rows of the table by the values of the named column,
+// matched against the headers of the table.  Groups are sorted by their
+// value, as SortBy sorts rows, and the rows in each group keep their order;
+// each group starts with a row spanning the table holding the value, and
+// groups are separated by a Separator, replacing any separators added
+// before.  If subtotals are given, each group ends with a row holding them,
+// with SubtotalLabel in the named column.  In CSV and JSON output, group
+// rows and subtotal rows are left out.  Calling GroupBy again regroups the
+// rows afresh.  It returns an error, and changes nothing, if a name does not
+// match a header.
+func (t *Table) GroupBy(column string, subtotals ...Subtotal) error {
+	names := []string{column}
+	for _, s := range subtotals {
+		names = append(names, s.Column)
+	}
+	indices, err := t.columnIndices(names)
+	if err != nil {
+		return err
+	}
+	key := indices[0]
+
+	rows := []*Row{}
+	for _, e := range t.elements {
+		if isDataRow(e) {
+			rows = append(rows, e.(*Row))
+		}
+	}
+	groups := [][]*Row{}
+	byKey := map[string]int{}
+	for _, row := range rows {
+		k := ""
+		if c := sortCell(row, key); c != nil {
+			k = filterColorCodes(c.formattedValue)
+		}
+		n, ok := byKey[k]
+		if !ok {
+			n = len(groups)
+			byKey[k] = n
+			groups = append(groups, nil)
+		}
+		groups[n] = append(groups[n], row)
+	}
+	sortGroups(groups, key)
+
+	elements := []Element{}
+	for n, group := range groups {
+		if n > 0 {
+			elements = append(elements, &Separator{})
+		}
+		elements = append(elements, groupHeaderRow(group[0], key))
+		for _, row := range group {
+			elements = append(elements, row)
+		}
+		if len(subtotals) > 0 {
+			elements = append(elements, subtotalRow(group, key, subtotals, indices[1:]))
+		}
+	}
+	t.elements = elements
+	return nil
+}
+
+// sortGroups sorts groups of rows by the value of the key column in their
+// first rows.
+func sortGroups(groups [][]*Row, key int) {
+	sort.SliceStable(groups, func(i, j int) bool {
+		return cellLess(sortCell(groups[i][0], key), sortCell(groups[j][0], key))
+	})
+}
+
+// groupHeaderRow returns the row starting the group of a row, spanning the
+// table and holding the row's value in the key column.
+func groupHeaderRow(row *Row, key int) *Row {
+	var cell *Cell
+	if c := sortCell(row, key); c != nil {
+		copied := *c
+		cell = &copied
+	} else {
+		cell = CreateCell("", nil)
+	}
+	align := AlignLeft
+	cell.alignment = &align
+	cell.colSpan = 999
+	header := CreateRow([]interface{}{cell})
+	header.groupHeader = true
+	return header
+}
+
+// subtotalRow returns the row ending a group, with the subtotals in the
+// given columns.
+func subtotalRow(group []*Row, key int, subtotals []Subtotal, columns []int) *Row {
+	width := key + 1
+	for _, column := range columns {
+		if column+1 > width {
+			width = column + 1
+		}
+	}
+	items := make([]interface{}, width)
+	for i := range items {
+		items[i] = ""
+	}
+	items[key] = SubtotalLabel
+	for n, s := range subtotals {
+		values := make([]interface{}, 0, len(group))
+		for _, row := range group {
+			if c := sortCell(row, columns[n]); c != nil {
+				values = append(values, c.value)
+			} else {
+				values = append(values, nil)
+			}
+		}
+		items[columns[n]] = s.Aggregate(values)
+	}
+	row := CreateRow(items)
+	row.subtotal = true
+	return row
+}
+
+// isDataRow reports whether an element is a row of values, rather than a
+// rule or a row added by GroupBy.
+func isDataRow(e Element) bool {
+	row, ok := e.(*Row)
+	return ok && !row.groupHeader && !row.subtotal
+}
+
+// AggregateSum returns the sum of the values which are numbers, or strings
+// holding numbers: an int64 if all of them are integers, and otherwise a
+// float64.  If there are none, it returns nil.
+func AggregateSum(values []interface{}) interface{} {
+	var sum float64
+	var isum int64
+	found, integers := false, true
+	for _, v := range values {
+		f, ok := valueNumber(v)
+		if !ok {
+			continue
+		}
+		found = true
+		sum += f
+		if i, ok := valueInteger(v); ok && integers {
+			isum += i
+		} else {
+			integers = false
+		}
+	}
+	switch {
+	case !found:
+		return nil
+	case integers:
+		return isum
+	}
+	return sum
+}
+
+// AggregateCount returns the number of values which are not empty, as an
+// int.
+func AggregateCount(values []interface{}) interface{} {
+	count := 0
+	for _, v := range values {
+		if !isEmptyValue(v) {
+			count++
+		}
+	}
+	return count
+}
+
+// AggregateMin returns the smallest of the values which are numbers, or nil
+// if there are none.
+func AggregateMin(values []interface{}) interface{} {
+	return extremeValue(values, func(a, b float64) bool { return a < b })
+}
+
+// AggregateMax returns the largest of the values which are numbers, or nil
+// if there are none.
+func AggregateMax(values []interface{}) interface{} {
+	return extremeValue(values, func(a, b float64) bool { return a > b })
+}
+
+// extremeValue returns the first number among values which no other beats.
+func extremeValue(values []interface{}, beats func(a, b float64) bool) interface{} {
+	var best interface{}
+	var bestNumber float64
+	for _, v := range values {
+		f, ok := valueNumber(v)
+		if !ok {
+			continue
+		}
+		if best == nil || beats(f, bestNumber) {
+			best, bestNumber = v, f
+		}
+	}
+	return best
+}
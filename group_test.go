@@ -0,0 +1,152 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func createGroupTestTable() *Table {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("DC", "Address", "Load", "Tokens")
+	table.AddRow("dc2", "10.0.1.1", 0.5, 256)
+	table.AddRow("dc1", "10.0.0.1", 12.25, 256)
+	table.AddSeparator()
+	table.AddRow("datacenter-three", "10.0.2.1", 3.0, 128)
+	table.AddRow("dc1", "10.0.0.2", nil, 16)
+	return table
+}
+
+func TestGroupBy(t *testing.T) {
+	table := createGroupTestTable()
+	if err := table.GroupBy("DC"); err != nil {
+		t.Fatal(err)
+	}
+	expected := "" +
+		"+------------------+----------+-------+--------+\n" +
+		"| DC               | Address  | Load  | Tokens |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| datacenter-three                             |\n" +
+		"| datacenter-three | 10.0.2.1 | 3.00  | 128    |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| dc1                                          |\n" +
+		"| dc1              | 10.0.0.1 | 12.25 | 256    |\n" +
+		"| dc1              | 10.0.0.2 |       | 16     |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| dc2                                          |\n" +
+		"| dc2              | 10.0.1.1 | 0.50  | 256    |\n" +
+		"+------------------+----------+-------+--------+\n"
+	checkRendersTo(t, table, expected)
+
+	// Sorting keeps the rows within their groups.
+	if err := table.SortBy("Tokens", false); err != nil {
+		t.Fatal(err)
+	}
+	expected = "" +
+		"+------------------+----------+-------+--------+\n" +
+		"| DC               | Address  | Load  | Tokens |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| datacenter-three                             |\n" +
+		"| datacenter-three | 10.0.2.1 | 3.00  | 128    |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| dc1                                          |\n" +
+		"| dc1              | 10.0.0.2 |       | 16     |\n" +
+		"| dc1              | 10.0.0.1 | 12.25 | 256    |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| dc2                                          |\n" +
+		"| dc2              | 10.0.1.1 | 0.50  | 256    |\n" +
+		"+------------------+----------+-------+--------+\n"
+	checkRendersTo(t, table, expected)
+
+	// Group rows are kept, and widen the table, when the column is hidden.
+	if err := table.HideColumns("DC", "Address"); err != nil {
+		t.Fatal(err)
+	}
+	expected = "" +
+		"+-------+----------+\n" +
+		"| Load  | Tokens   |\n" +
+		"+-------+----------+\n" +
+		"| datacenter-three |\n" +
+		"| 3.00  | 128      |\n" +
+		"+-------+----------+\n" +
+		"| dc1              |\n" +
+		"|       | 16       |\n" +
+		"| 12.25 | 256      |\n" +
+		"+-------+----------+\n" +
+		"| dc2              |\n" +
+		"| 0.50  | 256      |\n" +
+		"+-------+----------+\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestGroupBySubtotals(t *testing.T) {
+	table := createGroupTestTable()
+	table.AddTitle("Nodes of the cluster, by datacenter")
+	err := table.GroupBy("DC", Subtotal{"Load", AggregateSum}, Subtotal{"Tokens", AggregateSum},
+		Subtotal{"Address", AggregateCount})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "" +
+		"+----------------------------------------------+\n" +
+		"|     Nodes of the cluster, by datacenter      |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| DC               | Address  | Load  | Tokens |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| datacenter-three                             |\n" +
+		"| datacenter-three | 10.0.2.1 | 3.00  | 128    |\n" +
+		"| Subtotal         | 1        | 3.00  | 128    |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| dc1                                          |\n" +
+		"| dc1              | 10.0.0.1 | 12.25 | 256    |\n" +
+		"| dc1              | 10.0.0.2 |       | 16     |\n" +
+		"| Subtotal         | 2        | 12.25 | 272    |\n" +
+		"+------------------+----------+-------+--------+\n" +
+		"| dc2                                          |\n" +
+		"| dc2              | 10.0.1.1 | 0.50  | 256    |\n" +
+		"| Subtotal         | 1        | 0.50  | 256    |\n" +
+		"+------------------+----------+-------+--------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeCSV()
+	expected = "" +
+		"DC,Address,Load,Tokens\n" +
+		"datacenter-three,10.0.2.1,3.00,128\n" +
+		"dc1,10.0.0.1,12.25,256\n" +
+		"dc1,10.0.0.2,,16\n" +
+		"dc2,10.0.1.1,0.50,256\n"
+	checkRendersTo(t, table, expected)
+
+	if err := table.GroupBy("Rack"); err == nil {
+		t.Error("expected an error grouping by a missing column")
+	}
+	if err := table.GroupBy("DC", Subtotal{"Size", AggregateSum}); err == nil {
+		t.Error("expected an error for a subtotal of a missing column")
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	values := []interface{}{3, nil, "x", 2.5, uint8(7), "-1"}
+	tests := []struct {
+		name      string
+		aggregate Aggregate
+		values    []interface{}
+		expected  interface{}
+	}{
+		{"sum", AggregateSum, values, 11.5},
+		{"sum of integers", AggregateSum, []interface{}{3, int64(4), uint8(5)}, int64(12)},
+		{"sum of integer strings", AggregateSum, []interface{}{"3", "4", 5, ""}, int64(12)},
+		{"sum of nothing", AggregateSum, []interface{}{nil, "x"}, nil},
+		{"count", AggregateCount, values, 5},
+		{"min", AggregateMin, values, "-1"},
+		{"max", AggregateMax, values, uint8(7)},
+		{"max of nothing", AggregateMax, nil, nil},
+	}
+	for _, test := range tests {
+		if got := test.aggregate(test.values); got != test.expected {
+			t.Errorf("%s: got %#v, expected %#v", test.name, got, test.expected)
+		}
+	}
+}
@@ -16,32 +16,77 @@ const (
 	TitleAsThSpan
 )
 
+// HTMLAlignment selects how the alignment of a cell is expressed in HTML.
+type HTMLAlignment int
+
+const (
+	// HTMLAlignAttribute uses the (deprecated) align attribute; this is the
+	// default, for compatibility with earlier output.
+	HTMLAlignAttribute HTMLAlignment = iota
+	// HTMLAlignStyle uses an inline text-align style.
+	HTMLAlignStyle
+	// HTMLAlignClass adds a class of the form "align-right", for styling
+	// from an external stylesheet.
+	HTMLAlignClass
+)
+
+// HTMLOptions controls the markup generated by RenderHTML.  The zero value
+// gives the same output as earlier releases, other than for features such as
+// column-spanning which were previously not rendered at all, and for color
+// escape sequences, which earlier releases passed through unchanged and which
+// are now translated into styled span elements (see ANSI).
+type HTMLOptions struct {
+	// ID, if non-empty, is used as the id attribute of the table element.
+	ID string
+
+	// Classes are used as the class attribute of the table element; if nil,
+	// the single class "termtable" is used.
+	Classes []string
+
+	// Alignment selects how cell alignment is expressed.
+	Alignment HTMLAlignment
+
+	// HeaderScope adds scope="col" to header cells, for accessibility.
+	HeaderScope bool
+
+	// BorderCSS adds inline CSS to draw borders around the table and its
+	// cells, or to suppress them if the table style has SkipBorder set.
+	BorderCSS bool
+
+	// ANSI selects how color escape sequences in cells are represented.
+	ANSI HTMLANSIMode
+}
+
 // htmlStyleRules defines attributes which we can use, and might be set on a
 // table by accessors, to influence the type of HTML which is output.
 type htmlStyleRules struct {
-	title titleStyle
+	title   titleStyle
+	options HTMLOptions
 }
 
 // HTML returns an HTML representations of the contents of one row of a table.
 func (r *Row) HTML(tag string, style *renderStyle) string {
 	attrs := make([]string, len(r.cells))
 	elems := make([]string, len(r.cells))
+	columns := r.cellColumns()
 	for i := range r.cells {
-		if r.cells[i].alignment != nil {
-			switch *r.cells[i].alignment {
-			case AlignLeft:
-				attrs[i] = " align='left'"
-			case AlignCenter:
-				attrs[i] = " align='center'"
-			case AlignRight:
-				attrs[i] = " align='right'"
-			}
+		attrs[i] = r.cells[i].htmlAttributes(tag, columns[i], style)
+		if inner, ok := r.cells[i].value.(*Table); ok && inner != nil {
+			elems[i] = "\n" + inner.RenderHTML()
+			continue
 		}
-		elems[i] = html.EscapeString(strings.TrimSpace(r.cells[i].Render(style)))
+		elems[i] = style.htmlContent(strings.TrimSpace(r.cells[i].Render(style)))
 	}
 	// WAG as to max capacity, plus a bit
 	buf := bytes.NewBuffer(make([]byte, 0, 8192))
-	buf.WriteString("<tr>")
+	buf.WriteString("<tr")
+	if r.class != "" {
+		fmt.Fprintf(buf, " class=\"%s\"", html.EscapeString(r.class))
+	}
+	if r.level > 0 {
+		fmt.Fprintf(buf, " aria-level=\"%d\"", r.level)
+	}
+	buf.WriteString(">")
 	for i := range elems {
 		fmt.Fprintf(buf, "<%s%s>%s</%s>", tag, attrs[i], elems[i], tag)
 	}
@@ -49,8 +94,104 @@ func (r *Row) HTML(tag string, style *renderStyle) string {
 	return buf.String()
 }
 
+// htmlAttributes returns the attributes, each with a leading space, which
+// should be put on the th or td element holding this cell, which starts at
+// the given column.  This must be called before the cell is rendered, as
+// rendering fills in the alignment.
+func (c *Cell) htmlAttributes(tag string, column int, style *renderStyle) string {
+	opts := &style.htmlRules.options
+	classes := []string{}
+	styles := []string{}
+	attrs := ""
+
+	if c.class != "" {
+		classes = append(classes, c.class)
+	}
+	alignment := c.alignment
+	if alignment == nil && (style.Alignment == AlignAuto || style.Alignment == AlignDecimal) {
+		alignment = &style.Alignment
+	}
+	if alignment != nil {
+		name := ""
+		switch style.resolveAlignment(*alignment, c.column) {
+		case AlignLeft:
+			name = "left"
+		case AlignCenter:
+			name = "center"
+		case AlignRight, AlignDecimal:
+			name = "right"
+		}
+		if name != "" {
+			switch opts.Alignment {
+			case HTMLAlignStyle:
+				styles = append(styles, "text-align: "+name)
+			case HTMLAlignClass:
+				classes = append(classes, "align-"+name)
+			default:
+				attrs += " align='" + name + "'"
+			}
+		}
+	}
+	if opts.BorderCSS && !style.SkipBorder {
+		styles = append(styles, "border: 1px solid")
+	}
+
+	if len(classes) > 0 {
+		attrs = fmt.Sprintf(" class=\"%s\"", html.EscapeString(strings.Join(classes, " "))) + attrs
+	}
+	if len(styles) > 0 {
+		attrs += fmt.Sprintf(" style=\"%s\"", strings.Join(styles, "; "))
+	}
+
+	if span := c.colSpan; span > 1 {
+		// Spans such as the 999 used for titles mean "the rest of the row".
+		if style.columns > 0 && span > style.columns-column {
+			span = style.columns - column
+		}
+		if span > 1 {
+			attrs += fmt.Sprintf(" colspan=\"%d\"", span)
+		}
+	}
+	if c.rowSpan > 1 {
+		attrs += fmt.Sprintf(" rowspan=\"%d\"", c.rowSpan)
+	}
+	if tag == "th" && opts.HeaderScope {
+		attrs += " scope=\"col\""
+	}
+	return attrs
+}
+
+// htmlTableAttributes returns the attributes for the table element itself.
+func htmlTableAttributes(style *renderStyle) string {
+	opts := &style.htmlRules.options
+	attrs := ""
+	if opts.ID != "" {
+		attrs += fmt.Sprintf(" id=\"%s\"", html.EscapeString(opts.ID))
+	}
+	classes := opts.Classes
+	if classes == nil {
+		classes = []string{"termtable"}
+	}
+	if len(classes) > 0 {
+		attrs += fmt.Sprintf(" class=\"%s\"", html.EscapeString(strings.Join(classes, " ")))
+	}
+	if opts.BorderCSS {
+		if style.SkipBorder {
+			attrs += " style=\"border: none\""
+		} else {
+			attrs += " style=\"border-collapse: collapse; border: 1px solid\""
+		}
+	}
+	return attrs
+}
+
+// htmlContent returns the rendered content of a cell, made safe for HTML.
+func (s *renderStyle) htmlContent(content string) string {
+	return ansiToHTML(content, s.htmlRules.options.ANSI)
+}
+
 func generateHtmlTitleRow(title interface{}, t *Table, style *renderStyle) string {
-	elContent := html.EscapeString(
+	elContent := style.htmlContent(
 		strings.TrimSpace(CreateCell(t.title, &CellStyle{}).Render(style)),
 	)
 
@@ -71,6 +212,9 @@ func generateHtmlTitleRow(title interface{}, t *Table, style *renderStyle) strin
 // Thus we leave the padding in place to have columns align when viewed as
 // plain text and rely upon HTML ignoring extra whitespace.
 func (t *Table) RenderHTML() (buffer string) {
+	tree := t.tree
+	t = t.displayValues(false).withVisibleColumns()
+
 	// elements is already populated with row data
 
 	// generate the runtime style
@@ -78,7 +222,8 @@ func (t *Table) RenderHTML() (buffer string) {
 	style.PaddingLeft = 0
 	style.PaddingRight = 0
 
-	// TODO: control CSS styles to suppress border based upon t.Style.SkipBorder
+	style.htmlRules.options = t.htmlOptions
+
 	rowsText := make([]string, 0, len(t.elements)+6)
 
 	if t.title != nil || t.headers != nil {
@@ -93,15 +238,32 @@ func (t *Table) RenderHTML() (buffer string) {
 	}
 
 	rowsText = append(rowsText, "<tbody>\n")
-	// loop over the elements and render them
+	// loop over the elements and render them; separators start a new group
+	// of rows, so long as there are rows on both sides of them.
+	groupRows, pendingGroup := 0, false
 	for i := range t.elements {
-		if row, ok := t.elements[i].(*Row); ok {
-			rowsText = append(rowsText, row.HTML("td", style))
-		} else {
+		switch e := t.elements[i].(type) {
+		case *Row:
+			if pendingGroup {
+				rowsText = append(rowsText, "</tbody>\n<tbody>\n")
+				pendingGroup = false
+			}
+			rowsText = append(rowsText, e.HTML("td", style))
+			groupRows++
+		case *Separator, *StraightSeparator:
+			if groupRows > 0 {
+				pendingGroup = true
+				groupRows = 0
+			}
+		default:
 			rowsText = append(rowsText, fmt.Sprintf("<!-- unable to render line %d, unhandled type -->\n", i))
 		}
 	}
 	rowsText = append(rowsText, "</tbody>\n")
 
-	return "<table class=\"termtable\">\n" + strings.Join(rowsText, "") + "</table>\n"
+	attrs := htmlTableAttributes(style)
+	if tree {
+		attrs += " role=\"treegrid\""
+	}
+	return "<table" + attrs + ">\n" + strings.Join(rowsText, "") + "</table>\n"
 }
@@ -0,0 +1,187 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// HTMLDocumentOptions controls the output of RenderHTMLDocument.
+type HTMLDocumentOptions struct {
+	// Title is used as the title of the document; if empty, the table title
+	// is used.
+	Title string
+
+	// StripedRows shades alternate rows of the table body.
+	StripedRows bool
+
+	// StickyHeader keeps the header rows in view when scrolling.
+	StickyHeader bool
+
+	// Sortable adds a small script which sorts the rows of the table when a
+	// column header is clicked; clicking again reverses the order.
+	Sortable bool
+
+	// Filterable adds a text box and a small script which hides rows not
+	// containing the text typed into it.
+	Filterable bool
+
+	// ExtraCSS is appended to the embedded stylesheet.
+	ExtraCSS string
+}
+
+// RenderHTMLDocument returns a complete, self-contained HTML document holding
+// the table, with an embedded stylesheet which mirrors the terminal style of
+// the table (borders, padding and colors given by escape sequences in cells)
+// and, optionally, scripts for sorting and filtering rows.  The document uses
+// no external resources, so is suitable for sending as an email body.
+func (t *Table) RenderHTMLDocument(opts HTMLDocumentOptions) string {
+	tt := t.clone()
+	if tt.htmlOptions.ID == "" {
+		tt.htmlOptions.ID = "termtable"
+	}
+	tt.htmlOptions.Alignment = HTMLAlignClass
+	tt.htmlOptions.BorderCSS = false
+	tt.htmlOptions.ANSI = HTMLANSIClasses
+	id := tt.htmlOptions.ID
+
+	title := opts.Title
+	if title == "" && t.title != nil {
+		title = filterColorCodes(renderValue(t.title))
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 8192))
+	buf.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	if title != "" {
+		fmt.Fprintf(buf, "<title>%s</title>\n", html.EscapeString(title))
+	}
+	buf.WriteString("<style>\n")
+	buf.WriteString(htmlDocumentCSS(t.Style, opts))
+	buf.WriteString("</style>\n</head>\n<body>\n")
+	if opts.Filterable {
+		fmt.Fprintf(buf, "<input type=\"search\" class=\"termtable-filter\" placeholder=\"Filter\" data-table=\"%s\">\n",
+			html.EscapeString(id))
+	}
+	buf.WriteString(tt.RenderHTML())
+	if opts.Sortable || opts.Filterable {
+		buf.WriteString("<script>\n")
+		if opts.Sortable {
+			fmt.Fprintf(buf, htmlSortScript, jsString(id))
+		}
+		if opts.Filterable {
+			fmt.Fprintf(buf, htmlFilterScript, jsString(id))
+		}
+		buf.WriteString("</script>\n")
+	}
+	buf.WriteString("</body>\n</html>\n")
+	return buf.String()
+}
+
+// htmlDocumentCSS returns the embedded stylesheet for a table of the given
+// style.
+func htmlDocumentCSS(ts *TableStyle, opts HTMLDocumentOptions) string {
+	buf := bytes.NewBuffer(nil)
+	border := "1px solid #888"
+	if ts.SkipBorder {
+		border = "none"
+	}
+	buf.WriteString("body { font-family: monospace; }\n")
+	fmt.Fprintf(buf, "table { border-collapse: collapse; border: %s; }\n", border)
+	if ts.BorderTopLeft == "╭" {
+		// The UTF-8 box style has rounded corners; a collapsed border
+		// can't be rounded, so the outer border is drawn separately.
+		buf.WriteString("table { border-collapse: separate; border-spacing: 0; border-radius: 6px; }\n")
+	}
+	fmt.Fprintf(buf, "th, td { border: %s; padding: 0 %dch 0 %dch; white-space: pre; }\n",
+		border, ts.PaddingRight, ts.PaddingLeft)
+	buf.WriteString("caption { font-weight: bold; }\n")
+	buf.WriteString(".align-left { text-align: left; }\n")
+	buf.WriteString(".align-center { text-align: center; }\n")
+	buf.WriteString(".align-right { text-align: right; }\n")
+	if opts.StripedRows {
+		buf.WriteString("tbody tr:nth-child(even) { background-color: #f2f2f2; }\n")
+	}
+	if opts.StickyHeader {
+		buf.WriteString("thead th { position: sticky; top: 0; background-color: #fff; }\n")
+	}
+	if opts.Sortable {
+		buf.WriteString("thead tr:last-child th { cursor: pointer; }\n")
+	}
+	buf.WriteString(".ansi-bold { font-weight: bold; }\n")
+	buf.WriteString(".ansi-dim { opacity: 0.6; }\n")
+	buf.WriteString(".ansi-italic { font-style: italic; }\n")
+	buf.WriteString(".ansi-underline { text-decoration: underline; }\n")
+	buf.WriteString(".ansi-strike { text-decoration: line-through; }\n")
+	buf.WriteString(".ansi-underline.ansi-strike { text-decoration: underline line-through; }\n")
+	for _, bright := range []string{"", "bright-"} {
+		for _, name := range ansiColorNames {
+			fmt.Fprintf(buf, ".ansi-fg-%s%s { color: %s; }\n", bright, name, ansiPalette[bright+name])
+		}
+	}
+	for _, bright := range []string{"", "bright-"} {
+		for _, name := range ansiColorNames {
+			fmt.Fprintf(buf, ".ansi-bg-%s%s { background-color: %s; }\n", bright, name, ansiPalette[bright+name])
+		}
+	}
+	if opts.ExtraCSS != "" {
+		buf.WriteString(opts.ExtraCSS)
+		if !strings.HasSuffix(opts.ExtraCSS, "\n") {
+			buf.WriteString("\n")
+		}
+	}
+	return buf.String()
+}
+
+// jsString returns s as a JavaScript string literal which is safe to embed in
+// a script element.
+func jsString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "<", `\x3c`, ">", `\x3e`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// htmlSortScript sorts the rows of each tbody of the table, numerically when
+// both values are numbers; %s is the id of the table as a string literal.
+const htmlSortScript = `(function() {
+  var table = document.getElementById(%s);
+  var heads = table.tHead ? table.tHead.rows[table.tHead.rows.length - 1].cells : [];
+  Array.prototype.forEach.call(heads, function(th, col) {
+    th.addEventListener("click", function() {
+      var dir = th.getAttribute("data-sort") === "asc" ? -1 : 1;
+      Array.prototype.forEach.call(heads, function(h) { h.removeAttribute("data-sort"); });
+      th.setAttribute("data-sort", dir > 0 ? "asc" : "desc");
+      Array.prototype.forEach.call(table.tBodies, function(tbody) {
+        var rows = Array.prototype.slice.call(tbody.rows);
+        rows.sort(function(a, b) {
+          var x = a.cells[col] ? a.cells[col].textContent : "";
+          var y = b.cells[col] ? b.cells[col].textContent : "";
+          var nx = parseFloat(x), ny = parseFloat(y);
+          if (!isNaN(nx) && !isNaN(ny)) { return (nx - ny) * dir; }
+          return x.localeCompare(y) * dir;
+        });
+        rows.forEach(function(row) { tbody.appendChild(row); });
+      });
+    });
+  });
+})();
+`
+
+// htmlFilterScript hides the body rows of the table which do not contain the
+// text of the filter box; %s is the id of the table as a string literal.
+const htmlFilterScript = `(function() {
+  var id = %s;
+  var table = document.getElementById(id);
+  var input = Array.prototype.filter.call(document.querySelectorAll("input.termtable-filter"),
+    function(i) { return i.getAttribute("data-table") === id; })[0];
+  input.addEventListener("input", function() {
+    var text = input.value.toLowerCase();
+    Array.prototype.forEach.call(table.tBodies, function(tbody) {
+      Array.prototype.forEach.call(tbody.rows, function(row) {
+        row.style.display = row.textContent.toLowerCase().indexOf(text) >= 0 ? "" : "none";
+      });
+    });
+  });
+})();
+`
@@ -0,0 +1,63 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderHTMLDocument(t *testing.T) {
+	table := CreateTable()
+	table.AddTitle("Nightly <report>")
+	table.AddHeaders("Node", "Status")
+	table.AddRow("10.0.0.1", "\033[32mUP\033[0m")
+	table.AddRow("10.0.0.2", CreateCell("\033[31mDOWN\033[0m", &CellStyle{Alignment: AlignRight}))
+
+	output := table.RenderHTMLDocument(HTMLDocumentOptions{StripedRows: true, StickyHeader: true, Sortable: true})
+
+	for _, want := range []string{
+		"<!DOCTYPE html>\n",
+		"<title>Nightly &lt;report&gt;</title>\n",
+		"<table id=\"termtable\" class=\"termtable\">\n",
+		"<tr><td>10.0.0.1</td><td><span class=\"ansi-fg-green\">UP</span></td></tr>\n",
+		"<td class=\"align-right\"><span class=\"ansi-fg-red\">DOWN</span></td>",
+		"tbody tr:nth-child(even)",
+		"position: sticky",
+		"document.getElementById(\"termtable\")",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Document does not contain %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "termtable-filter") {
+		t.Error("Document contains a filter box which was not asked for")
+	}
+	if strings.Contains(output, "\033") {
+		t.Error("Document contains raw escape sequences")
+	}
+}
+
+func TestRenderHTMLDocumentPaddingAndFilter(t *testing.T) {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.Style.PaddingLeft = 1
+	table.Style.PaddingRight = 3
+	table.SetHTMLOptions(HTMLOptions{ID: "it's"})
+	table.AddHeaders("Node")
+	table.AddRow("10.0.0.1")
+
+	output := table.RenderHTMLDocument(HTMLDocumentOptions{Filterable: true})
+
+	for _, want := range []string{
+		"padding: 0 3ch 0 1ch;",
+		"<input type=\"search\" class=\"termtable-filter\" placeholder=\"Filter\" data-table=\"it&#39;s\">\n",
+		"var id = \"it's\";",
+		"i.getAttribute(\"data-table\") === id",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Document does not contain %q:\n%s", want, output)
+		}
+	}
+}
@@ -220,3 +220,108 @@ func TestTableWithAltTitleStyle(t *testing.T) {
 		t.Fatal(DisplayFailedOutput(output, expected))
 	}
 }
+
+func TestTableHTMLOptions(t *testing.T) {
+	expected := "" +
+		"<table id=\"nodes\" class=\"wide striped\" style=\"border-collapse: collapse; border: 1px solid\">\n" +
+		"<thead>\n" +
+		"<tr><th style=\"border: 1px solid\" scope=\"col\">Name</th><th style=\"border: 1px solid\" scope=\"col\">Load</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr class=\"down\"><td class=\"host\" style=\"border: 1px solid\">alpha</td><td style=\"text-align: right; border: 1px solid\">0.5</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.SetModeHTML()
+	table.SetHTMLOptions(HTMLOptions{
+		ID:          "nodes",
+		Classes:     []string{"wide", "striped"},
+		Alignment:   HTMLAlignStyle,
+		HeaderScope: true,
+		BorderCSS:   true,
+	})
+	table.AddHeaders("Name", "Load")
+	row := table.AddRow(CreateCell("alpha", &CellStyle{HTMLClass: "host"}),
+		CreateCell("0.5", &CellStyle{Alignment: AlignRight}))
+	row.SetHTMLClass("down")
+
+	output := table.Render()
+	if output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+}
+
+func TestTableHTMLSpanClampedByColumn(t *testing.T) {
+	expected := "" +
+		"<table>\n" +
+		"<tbody>\n" +
+		"<tr><td>w</td><td>x</td><td>y</td><td>z</td></tr>\n" +
+		"<tr><td colspan=\"2\">A</td><td colspan=\"2\">B</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.SetModeHTML()
+	table.SetHTMLOptions(HTMLOptions{Classes: []string{}})
+	table.AddRow("w", "x", "y", "z")
+	table.AddRow(CreateCell("A", &CellStyle{ColSpan: 2}),
+		CreateCell("B", &CellStyle{ColSpan: 999}))
+
+	output := table.Render()
+	if output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+}
+
+func TestTableHTMLAlignClassAndSpans(t *testing.T) {
+	expected := "" +
+		"<table>\n" +
+		"<tbody>\n" +
+		"<tr><td class=\"align-center\" colspan=\"2\">both</td><td rowspan=\"2\">tall</td></tr>\n" +
+		"<tr><td>a</td><td>b</td></tr>\n" +
+		"<tr><td>x</td><td>y</td><td>z</td></tr>\n" +
+		"<tr><td colspan=\"3\">rest</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.SetModeHTML()
+	table.SetHTMLOptions(HTMLOptions{Classes: []string{}, Alignment: HTMLAlignClass})
+	table.AddRow(CreateCell("both", &CellStyle{Alignment: AlignCenter, ColSpan: 2}),
+		CreateCell("tall", &CellStyle{RowSpan: 2}))
+	table.AddRow("a", "b")
+	table.AddRow("x", "y", "z")
+	table.AddRow(CreateCell("rest", &CellStyle{ColSpan: 999}))
+
+	output := table.Render()
+	if output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+}
+
+func TestTableHTMLSeparatorGroups(t *testing.T) {
+	expected := "" +
+		"<table class=\"termtable\">\n" +
+		"<tbody>\n" +
+		"<tr><td>a</td></tr>\n" +
+		"</tbody>\n" +
+		"<tbody>\n" +
+		"<tr><td>b</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	table := CreateTable()
+	table.SetModeHTML()
+	table.AddSeparator()
+	table.AddRow("a")
+	table.AddSeparator()
+	table.AddSeparator()
+	table.AddRow("b")
+	table.AddSeparator()
+
+	output := table.Render()
+	if output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// SetModeJSON switches this table to be in JSON mode, for consumption by
+// other programs; see RenderJSON.
+func (t *Table) SetModeJSON() {
+	t.outputMode = outputJSON
+}
+
+// RenderJSON returns a representation of the table as a JSON array with an
+// element for each row: an object keyed by header, or an array if the table
+// has no headers.  Numbers and booleans added to the table are given as
+// such, nested tables as arrays of their own, other values as the strings
+// they are rendered as, without color escape sequences; the title,
+// separators and rows added by GroupBy are omitted.  Each row is on a line
+// of its own.
+func (t *Table) RenderJSON() string {
+	t = t.withVisibleColumns()
+	rows := [][]byte{}
+	for _, e := range t.elements {
+		if isDataRow(e) {
+			rows = append(rows, t.jsonRow(e.(*Row)))
+		}
+	}
+	if len(rows) == 0 {
+		return "[]\n"
+	}
+
+	b := bytes.NewBufferString("[\n")
+	b.Write(bytes.Join(rows, []byte(",\n")))
+	b.WriteString("\n]\n")
+	return b.String()
+}
+
+// jsonRow returns the JSON for one row.
+func (t *Table) jsonRow(r *Row) []byte {
+	b := bytes.NewBuffer(nil)
+	if t.headers == nil {
+		b.WriteByte('[')
+		for i, c := range r.cells {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.Write(jsonMarshal(c.jsonValue()))
+		}
+		b.WriteByte(']')
+		return b.Bytes()
+	}
+
+	b.WriteByte('{')
+	columns := r.cellColumns()
+	for i, c := range r.cells {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		name := strconv.Itoa(columns[i] + 1)
+		if columns[i] < len(t.headers) {
+			name = headerName(t.headers[columns[i]])
+		}
+		b.Write(jsonMarshal(name))
+		b.WriteByte(':')
+		b.Write(jsonMarshal(c.jsonValue()))
+	}
+	b.WriteByte('}')
+	return b.Bytes()
+}
+
+// jsonValue returns the value of the cell to be given in JSON.
+func (c *Cell) jsonValue() interface{} {
+	if c.empty {
+		if _, ok := c.value.(string); ok {
+			return ""
+		}
+		return nil
+	}
+	value := c.value
+	if valuer, ok := value.(driver.Valuer); ok {
+		if v, err := valuer.Value(); err == nil {
+			value = v
+		}
+	}
+	switch v := value.(type) {
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return v
+	case float32:
+		if !math.IsNaN(float64(v)) && !math.IsInf(float64(v), 0) {
+			return v
+		}
+	case float64:
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			return v
+		}
+	case json.Number:
+		return v
+	case *Table:
+		return json.RawMessage(v.RenderJSON())
+	}
+	return filterColorCodes(c.formattedValue)
+}
+
+// jsonMarshal returns the JSON for a value which is known to be encodable,
+// without escaping HTML characters.
+func jsonMarshal(v interface{}) []byte {
+	b := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n"))
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRenderJSON(t *testing.T) {
+	table := CreateTable()
+	table.AddTitle("Ignored")
+	table.AddHeaders("Name", "Size", "Ratio", "OK")
+	table.AddRow("a <b>", 10, 0.5, true)
+	table.AddSeparator()
+	table.AddRow("\033[1mc\033[0m", uint8(2), math.NaN(), "no")
+	table.AddRow("d")
+	table.SetModeJSON()
+
+	expected := "" +
+		"[\n" +
+		`{"Name":"a <b>","Size":10,"Ratio":0.5,"OK":true},` + "\n" +
+		`{"Name":"c","Size":2,"Ratio":"NaN","OK":"no"},` + "\n" +
+		`{"Name":"d"}` + "\n" +
+		"]\n"
+	checkRendersTo(t, table, expected)
+
+	if err := table.ShowColumns("OK", "Name"); err != nil {
+		t.Fatal(err)
+	}
+	expected = "" +
+		"[\n" +
+		`{"OK":true,"Name":"a <b>"},` + "\n" +
+		`{"OK":"no","Name":"c"},` + "\n" +
+		`{"OK":"","Name":"d"}` + "\n" +
+		"]\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestRenderJSONWithoutHeaders(t *testing.T) {
+	table := CreateTable()
+	table.AddRow("a", 1)
+	table.AddRow("b", 2.25)
+	table.SetModeJSON()
+
+	expected := "" +
+		"[\n" +
+		`["a",1],` + "\n" +
+		`["b",2.25]` + "\n" +
+		"]\n"
+	checkRendersTo(t, table, expected)
+
+	empty := CreateTable()
+	empty.SetModeJSON()
+	checkRendersTo(t, empty, "[]\n")
+}
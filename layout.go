@@ -0,0 +1,48 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Layout describes a table as drawn in terminal mode, for programs such as
+// pagers which display it piecemeal.
+type Layout struct {
+	// Lines are the lines of the drawn table, without newlines.
+	Lines []string
+
+	// HeaderLines is the number of leading Lines holding the title,
+	// headers and the rules around them, which should be kept in view when
+	// scrolling through the rest.
+	HeaderLines int
+
+	// ColumnEdges are the display columns, counting from 0, at which the
+	// vertical borders are drawn in rows of the table, from left to right.
+	ColumnEdges []int
+}
+
+// Layout returns the layout of the table as drawn in terminal mode, with the
+// visible columns, but ignoring settings for fitting the table within
+// MaxColumns or splitting it into pages: a pager does those itself.
+func (t *Table) Layout() *Layout {
+	visible := t.withDisplayValues().withVisibleColumns()
+	tt, style := visible.terminalLayout()
+
+	l := &Layout{
+		Lines:       strings.Split(strings.TrimSuffix(drawTerminal(tt, style), "\n"), "\n"),
+		HeaderLines: len(tt.elements) - len(visible.elements),
+	}
+	if visible.headers == nil && visible.title == nil {
+		l.HeaderLines = 0
+	}
+
+	edge := 0
+	l.ColumnEdges = append(l.ColumnEdges, edge)
+	for i := 0; i < style.columns; i++ {
+		edge += utf8.RuneCountInString(style.BorderY) + style.PaddingLeft + style.CellWidth(i) + style.PaddingRight
+		l.ColumnEdges = append(l.ColumnEdges, edge)
+	}
+	return l
+}
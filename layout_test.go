@@ -0,0 +1,26 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestLayout(t *testing.T) {
+	table := CreateTable()
+	table.AddTitle("Nodes")
+	table.AddHeaders("Address", "DC")
+	table.AddRow("10.0.0.1", "dc1")
+
+	layout := table.Layout()
+	if len(layout.Lines) != 7 || layout.Lines[5] != "| 10.0.0.1 | dc1 |" {
+		t.Fatalf("Unexpected lines: %q", layout.Lines)
+	}
+	if layout.HeaderLines != 5 {
+		t.Fatal("Unexpected number of header lines:", layout.HeaderLines)
+	}
+	edges := layout.ColumnEdges
+	if len(edges) != 3 || edges[0] != 0 || edges[1] != 11 || edges[2] != 17 {
+		t.Fatal("Unexpected column edges:", edges)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	runewidth "github.com/mattn/go-runewidth"
+	"github.com/scylladb/termtables/term"
+)
+
+// A LiveTable draws successive versions of a table, such as in a monitoring
+// command, in place on a terminal.  Only the lines which have changed since
+// the last update are redrawn; lines are cut at the width of the terminal,
+// and a table taller than the terminal is cut short, so that nothing wraps
+// or scrolls and upsets the cursor movement, and a resize of the terminal
+// causes the screen to be cleared and the table drawn afresh.
+//
+// When the output is not a terminal, each update writes the whole table,
+// after the previous ones.
+type LiveTable struct {
+	out      io.Writer
+	size     func() (term.Size, error)
+	previous []string
+	width    int
+}
+
+// NewLiveTable returns a LiveTable which writes to out; it draws in place if
+// out is an *os.File which is a terminal.
+func NewLiveTable(out io.Writer) *LiveTable {
+	l := &LiveTable{out: out}
+	if f, ok := out.(*os.File); ok {
+		if _, err := term.GetTerminalWindowSize(f); err == nil {
+			l.size = func() (term.Size, error) {
+				size, err := term.GetTerminalWindowSize(f)
+				if err != nil {
+					return term.Size{}, err
+				}
+				return *size, nil
+			}
+		}
+	}
+	return l
+}
+
+// Update draws the table, replacing whatever was drawn by the last update.
+func (l *LiveTable) Update(t *Table) error {
+	rendered := t.Render()
+	if l.size == nil {
+		_, err := io.WriteString(l.out, rendered)
+		return err
+	}
+
+	size, err := l.size()
+	if err != nil {
+		return err
+	}
+	width := size.Columns
+	lines := strings.Split(strings.TrimSuffix(rendered, "\n"), "\n")
+	// The cursor ends on the line after the table, which must be on the
+	// screen too.
+	if size.Lines > 0 && len(lines) >= size.Lines {
+		lines = lines[:size.Lines-1]
+	}
+	for i := range lines {
+		lines[i] = truncateWidth(lines[i], width)
+	}
+
+	b := bytes.NewBuffer(nil)
+	previous := l.previous
+	if width != l.width && l.width != 0 {
+		// The terminal may have reflowed what was drawn, so we can't
+		// tell where it is; start again from the top of the screen.
+		b.WriteString("\033[H\033[2J")
+		previous = nil
+	} else if len(previous) > 0 {
+		fmt.Fprintf(b, "\033[%dA", len(previous))
+	}
+	for i, line := range lines {
+		if i < len(previous) && previous[i] == line {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString("\r" + line + "\033[K\n")
+	}
+	if len(lines) < len(previous) {
+		b.WriteString("\033[J")
+	}
+
+	l.previous, l.width = lines, width
+	_, err = l.out.Write(b.Bytes())
+	return err
+}
+
+// truncateWidth returns s cut to at most width display columns, keeping any
+// color escape sequences before the cut and resetting colors after it.
+func truncateWidth(s string, width int) string {
+	if runewidth.StringWidth(filterColorCodes(s)) <= width {
+		return s
+	}
+	b := bytes.NewBuffer(nil)
+	colored := false
+	for _, u := range terminalUnits(s) {
+		if strings.HasPrefix(u.text, "\033") {
+			b.WriteString(u.text)
+			colored = true
+			continue
+		}
+		if u.column+runewidth.StringWidth(u.text) > width {
+			break
+		}
+		b.WriteString(u.text)
+	}
+	if colored {
+		b.WriteString("\033[0m")
+	}
+	return b.String()
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/scylladb/termtables/term"
+)
+
+func createLiveTestTable(rows ...string) *Table {
+	table := CreateTable()
+	table.AddHeaders("Node")
+	for _, row := range rows {
+		table.AddRow(row)
+	}
+	return table
+}
+
+func TestLiveTableNotTerminal(t *testing.T) {
+	b := bytes.NewBuffer(nil)
+	live := NewLiveTable(b)
+	first, second := createLiveTestTable("a"), createLiveTestTable("b")
+	live.Update(first)
+	live.Update(second)
+	if b.String() != first.Render()+second.Render() {
+		t.Fatalf("Unexpected output %q", b.String())
+	}
+}
+
+func TestLiveTableInPlace(t *testing.T) {
+	b := bytes.NewBuffer(nil)
+	size := term.Size{Lines: 24, Columns: 80}
+	live := &LiveTable{out: b, size: func() (term.Size, error) { return size, nil }}
+
+	live.Update(createLiveTestTable("a"))
+	expected := "" +
+		"\r+------+\033[K\n" +
+		"\r| Node |\033[K\n" +
+		"\r+------+\033[K\n" +
+		"\r| a    |\033[K\n" +
+		"\r+------+\033[K\n"
+	if b.String() != expected {
+		t.Fatalf("Unexpected first update %q", b.String())
+	}
+
+	b.Reset()
+	live.Update(createLiveTestTable("a", "b"))
+	expected = "\033[5A\n\n\n\n" +
+		"\r| b    |\033[K\n" +
+		"\r+------+\033[K\n"
+	if b.String() != expected {
+		t.Fatalf("Unexpected growing update %q", b.String())
+	}
+
+	b.Reset()
+	live.Update(createLiveTestTable("c"))
+	expected = "\033[6A\n\n\n" +
+		"\r| c    |\033[K\n" +
+		"\r+------+\033[K\n" +
+		"\033[J"
+	if b.String() != expected {
+		t.Fatalf("Unexpected shrinking update %q", b.String())
+	}
+
+	b.Reset()
+	size.Columns = 5
+	live.Update(createLiveTestTable("c"))
+	expected = "\033[H\033[2J" +
+		"\r+----\033[K\n" +
+		"\r| Nod\033[K\n" +
+		"\r+----\033[K\n" +
+		"\r| c  \033[K\n" +
+		"\r+----\033[K\n"
+	if b.String() != expected {
+		t.Fatalf("Unexpected update after resize %q", b.String())
+	}
+}
+
+func TestLiveTableTallerThanTerminal(t *testing.T) {
+	b := bytes.NewBuffer(nil)
+	size := term.Size{Lines: 4, Columns: 80}
+	live := &LiveTable{out: b, size: func() (term.Size, error) { return size, nil }}
+
+	live.Update(createLiveTestTable("a", "b"))
+	expected := "" +
+		"\r+------+\033[K\n" +
+		"\r| Node |\033[K\n" +
+		"\r+------+\033[K\n"
+	if b.String() != expected {
+		t.Fatalf("Unexpected first update %q", b.String())
+	}
+
+	b.Reset()
+	live.Update(createLiveTestTable("c", "d"))
+	expected = "\033[3A\n\n\n"
+	if b.String() != expected {
+		t.Fatalf("Unexpected second update %q", b.String())
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		out   string
+	}{
+		{"abc", 5, "abc"},
+		{"abcdef", 3, "abc"},
+		{"日本語", 5, "日本"},
+		{"\033[31mred\033[0m and more", 4, "\033[31mred\033[0m \033[0m"},
+	}
+	for _, test := range tests {
+		if got := truncateWidth(test.in, test.width); got != test.out {
+			t.Errorf("Expected %q but got %q from %q cut to %d", test.out, got, test.in, test.width)
+		}
+	}
+}
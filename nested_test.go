@@ -0,0 +1,146 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"strings"
+	"testing"
+)
+
+func createNestedTestTable() *Table {
+	inner := CreateTable()
+	inner.Style = &TableStyle{}
+	*inner.Style = *DefaultStyle
+	inner.AddHeaders("Rack", "Nodes")
+	inner.AddRow("r1", 3)
+	inner.AddRow("r2", 12)
+
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("DC", "Racks", "Load")
+	table.AddRow("dc1", inner, 1.5)
+	table.AddRow("dc2", "none", 22.25)
+	table.SetAlign(AlignRight, 3)
+	return table
+}
+
+func TestNestedTable(t *testing.T) {
+	table := createNestedTestTable()
+	expected := "" +
+		"+-----+------------------+-------+\n" +
+		"| DC  | Racks            | Load  |\n" +
+		"+-----+------------------+-------+\n" +
+		"| dc1 | +------+-------+ |  1.50 |\n" +
+		"|     | | Rack | Nodes | |       |\n" +
+		"|     | +------+-------+ |       |\n" +
+		"|     | | r1   | 3     | |       |\n" +
+		"|     | | r2   | 12    | |       |\n" +
+		"|     | +------+-------+ |       |\n" +
+		"| dc2 | none             | 22.25 |\n" +
+		"+-----+------------------+-------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeJSON()
+	expected = "" +
+		"[\n" +
+		`{"DC":"dc1","Racks":[{"Rack":"r1","Nodes":3},{"Rack":"r2","Nodes":12}],"Load":1.5},` + "\n" +
+		`{"DC":"dc2","Racks":"none","Load":22.25}` + "\n" +
+		"]\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestNestedTableHTML(t *testing.T) {
+	table := createNestedTestTable()
+	table.SetModeHTML()
+	expected := "" +
+		"<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>DC</th><th>Racks</th><th>Load</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>dc1</td><td>\n" +
+		"<table class=\"termtable\">\n" +
+		"<thead>\n" +
+		"<tr><th>Rack</th><th>Nodes</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>r1</td><td>3</td></tr>\n" +
+		"<tr><td>r2</td><td>12</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n" +
+		"</td><td align='right'>1.50</td></tr>\n" +
+		"<tr><td>dc2</td><td>none</td><td align='right'>22.25</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+	checkRendersTo(t, table, expected)
+
+	table = createNestedTestTable()
+	table.SetModeMarkdown()
+	inline := "<table class=\"termtable\"><thead><tr><th>Rack</th><th>Nodes</th></tr></thead>" +
+		"<tbody><tr><td>r1</td><td>3</td></tr><tr><td>r2</td><td>12</td></tr></tbody></table>"
+	if got := table.Render(); got != ""+
+		"| DC  | Racks"+strings.Repeat(" ", len(inline)-5)+" | Load  |\n"+
+		"| --- | "+strings.Repeat("-", len(inline))+" | ----- |\n"+
+		"| dc1 | "+inline+" |  1.50 |\n"+
+		"| dc2 | none"+strings.Repeat(" ", len(inline)-4)+" | 22.25 |\n" {
+		t.Errorf("Markdown with a nested table, got:\n%s", got)
+	}
+}
+
+func TestNestedTableSettings(t *testing.T) {
+	inner := CreateTable()
+	inner.Style = &TableStyle{}
+	*inner.Style = *DefaultStyle
+	inner.AddRow("\033[31mdown\033[0m")
+
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddRow("n1", inner)
+	inner.AddRow("up")
+	table.UTF8Box()
+	table.SetColor(false)
+	expected := "" +
+		"╭────┬──────────╮\n" +
+		"│ n1 │ ╭──────╮ │\n" +
+		"│    │ │ down │ │\n" +
+		"│    │ │ up   │ │\n" +
+		"│    │ ╰──────╯ │\n" +
+		"╰────┴──────────╯\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeHTML()
+	table.SetHTMLOptions(HTMLOptions{ANSI: HTMLANSIClasses})
+	if got := table.Render(); !strings.Contains(got, "<span class=\"ansi-fg-red\">down</span></td>") {
+		t.Errorf("Nested table without the ANSI mode of the outer one, got:\n%s", got)
+	}
+
+	table.SetModeCSV()
+	expected = "" +
+		"n1,\"╭──────╮\n" +
+		"│ down │\n" +
+		"│ up   │\n" +
+		"╰──────╯\"\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestMultiLineCells(t *testing.T) {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Notes")
+	table.AddRow("a", "first line\nsecond")
+	table.AddRow(CreateCell("b\nc\nd", &CellStyle{Alignment: AlignRight}), "x")
+	expected := "" +
+		"+------+------------+\n" +
+		"| Name | Notes      |\n" +
+		"+------+------------+\n" +
+		"| a    | first line |\n" +
+		"|      | second     |\n" +
+		"|    b | x          |\n" +
+		"|    c |            |\n" +
+		"|    d |            |\n" +
+		"+------+------------+\n"
+	checkRendersTo(t, table, expected)
+}
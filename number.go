@@ -0,0 +1,256 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/scylladb/termtables/term"
+)
+
+// NumberFormat controls how numbers added to a table are shown in terminal,
+// Markdown and HTML output, when set with SetNumberFormat or
+// SetColumnNumberFormat; CSV and JSON output is not affected.  Only values
+// of the built-in integer and floating-point types are formatted, not
+// strings or other types.
+type NumberFormat struct {
+	// Locale names the locale whose decimal and thousands separators are
+	// used, such as "de_DE"; if empty, it is taken from the environment, per
+	// term.Locale("LC_NUMERIC").  Separators are known for common locales,
+	// with others using those of English.
+	Locale string
+
+	// Grouping inserts thousands separators.
+	Grouping bool
+
+	// Decimals is the number of digits after the decimal point shown for
+	// floating-point numbers; if zero, two are shown, as without a
+	// NumberFormat.  DecimalsNone shows none, and DecimalsShortest as many
+	// as are needed.
+	Decimals int
+
+	// Compact shows numbers of a thousand or more in short form, with one
+	// decimal and a suffix, as 1.2K, 3.4M, 5.6B and 7.8T.
+	Compact bool
+}
+
+// These constants can be given as the Decimals of a NumberFormat.
+const (
+	// DecimalsShortest shows as many decimals as are needed to tell the
+	// number apart from others.
+	DecimalsShortest = -1
+
+	// DecimalsNone rounds numbers to whole numbers.
+	DecimalsNone = -2
+)
+
+// decimals returns the number of decimals to give strconv.FormatFloat.
+func (f *NumberFormat) decimals() int {
+	switch {
+	case f.Decimals == 0:
+		return 2
+	case f.Decimals == DecimalsNone:
+		return 0
+	case f.Decimals < 0:
+		return -1
+	}
+	return f.Decimals
+}
+
+// numberSymbols are the separators used in numbers by a locale.
+type numberSymbols struct {
+	decimal, group string
+}
+
+// localeNumberSymbols maps locales, by language or by language and
+// territory, to their number separators, per the CLDR.
+var localeNumberSymbols = map[string]numberSymbols{
+	"en":    {".", ","},
+	"ja":    {".", ","},
+	"zh":    {".", ","},
+	"ko":    {".", ","},
+	"th":    {".", ","},
+	"he":    {".", ","},
+	"es_MX": {".", ","},
+	"de":    {",", "."},
+	"es":    {",", "."},
+	"it":    {",", "."},
+	"nl":    {",", "."},
+	"pt":    {",", "."},
+	"da":    {",", "."},
+	"el":    {",", "."},
+	"id":    {",", "."},
+	"tr":    {",", "."},
+	"de_CH": {".", "’"},
+	"it_CH": {".", "’"},
+	"de_AT": {",", "\u00a0"},
+	"fr":    {",", "\u202f"},
+	"ru":    {",", "\u00a0"},
+	"uk":    {",", "\u00a0"},
+	"pl":    {",", "\u00a0"},
+	"cs":    {",", "\u00a0"},
+	"sk":    {",", "\u00a0"},
+	"hu":    {",", "\u00a0"},
+	"bg":    {",", "\u00a0"},
+	"fi":    {",", "\u00a0"},
+	"sv":    {",", "\u00a0"},
+	"nb":    {",", "\u00a0"},
+	"no":    {",", "\u00a0"},
+	"lt":    {",", "\u00a0"},
+	"lv":    {",", "\u00a0"},
+	"et":    {",", "\u00a0"},
+}
+
+// symbols returns the separators for the format's locale.
+func (f *NumberFormat) symbols() numberSymbols {
+	locale := f.Locale
+	if locale == "" {
+		locale = term.Locale("LC_NUMERIC")
+	}
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	locale = strings.Replace(locale, "-", "_", -1)
+	if s, ok := localeNumberSymbols[locale]; ok {
+		return s
+	}
+	if i := strings.IndexByte(locale, '_'); i >= 0 {
+		if s, ok := localeNumberSymbols[strings.ToLower(locale[:i])]; ok {
+			return s
+		}
+	}
+	if s, ok := localeNumberSymbols[strings.ToLower(locale)]; ok {
+		return s
+	}
+	return localeNumberSymbols["en"]
+}
+
+// compactSuffixes are the suffixes of compact numbers, for each power of a
+// thousand.
+var compactSuffixes = []string{"", "K", "M", "B", "T"}
+
+// format returns a number formatted, and the decimal separator used; ok is
+// false if v is not a number of a built-in type.
+func (f *NumberFormat) format(v interface{}) (s string, decimal string, ok bool) {
+	var digits string
+	float := false
+	var x float64
+	switch n := v.(type) {
+	case int:
+		digits, x = strconv.FormatInt(int64(n), 10), float64(n)
+	case int8:
+		digits, x = strconv.FormatInt(int64(n), 10), float64(n)
+	case int16:
+		digits, x = strconv.FormatInt(int64(n), 10), float64(n)
+	case int32:
+		digits, x = strconv.FormatInt(int64(n), 10), float64(n)
+	case int64:
+		digits, x = strconv.FormatInt(n, 10), float64(n)
+	case uint:
+		digits, x = strconv.FormatUint(uint64(n), 10), float64(n)
+	case uint8:
+		digits, x = strconv.FormatUint(uint64(n), 10), float64(n)
+	case uint16:
+		digits, x = strconv.FormatUint(uint64(n), 10), float64(n)
+	case uint32:
+		digits, x = strconv.FormatUint(uint64(n), 10), float64(n)
+	case uint64:
+		digits, x = strconv.FormatUint(n, 10), float64(n)
+	case float32:
+		x, float = float64(n), true
+	case float64:
+		x, float = n, true
+	default:
+		return "", "", false
+	}
+	symbols := f.symbols()
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return strconv.FormatFloat(x, 'f', -1, 64), symbols.decimal, true
+	}
+
+	suffix := ""
+	if f.Compact && math.Abs(x) >= 1000 {
+		scale := 0
+		for scale < len(compactSuffixes)-1 && math.Abs(x) >= 1000 {
+			x /= 1000
+			scale++
+		}
+		digits = strconv.FormatFloat(x, 'f', 1, 64)
+		if math.Abs(x) >= 999.95 && scale < len(compactSuffixes)-1 {
+			x /= 1000
+			scale++
+			digits = strconv.FormatFloat(x, 'f', 1, 64)
+		}
+		digits = strings.TrimSuffix(digits, ".0")
+		suffix = compactSuffixes[scale]
+	} else if float {
+		digits = strconv.FormatFloat(x, 'f', f.decimals(), 64)
+	}
+
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	integer, fraction := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		integer, fraction = digits[:i], digits[i+1:]
+	}
+	if f.Grouping {
+		integer = groupDigits(integer, symbols.group)
+	}
+	s = sign + integer
+	if fraction != "" {
+		s += symbols.decimal + fraction
+	}
+	return s + suffix, symbols.decimal, true
+}
+
+// groupDigits inserts a separator between each group of three digits.
+func groupDigits(digits, separator string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	first := len(digits) % 3
+	if first == 0 {
+		first = 3
+	}
+	b.WriteString(digits[:first])
+	for i := first; i < len(digits); i += 3 {
+		b.WriteString(separator)
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+// SetNumberFormat sets how numbers are shown in all columns of this table,
+// except those given their own with SetColumnNumberFormat; nil turns
+// formatting off, showing numbers as they were added.
+func (t *Table) SetNumberFormat(f *NumberFormat) {
+	t.numberFormat = f
+}
+
+// SetColumnNumberFormat sets how numbers are shown in the given columns,
+// numbered from 1; nil reverts them to the format of the table.
+func (t *Table) SetColumnNumberFormat(f *NumberFormat, columns ...int) {
+	if t.columnNumberFormats == nil {
+		t.columnNumberFormats = map[int]*NumberFormat{}
+	}
+	for _, column := range columns {
+		if f == nil {
+			delete(t.columnNumberFormats, column-1)
+		} else {
+			t.columnNumberFormats[column-1] = f
+		}
+	}
+}
+
+// columnNumberFormat returns the format for numbers in a column, or nil.
+func (t *Table) columnNumberFormat(column int) *NumberFormat {
+	if f, ok := t.columnNumberFormats[column]; ok {
+		return f
+	}
+	return t.numberFormat
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		format NumberFormat
+		value  interface{}
+		want   string
+	}{
+		{NumberFormat{Locale: "en_US", Grouping: true}, 1234567, "1,234,567"},
+		{NumberFormat{Locale: "en_US", Grouping: true}, -1234, "-1,234"},
+		{NumberFormat{Locale: "en_US", Grouping: true}, 123, "123"},
+		{NumberFormat{Locale: "en_US"}, 1234567, "1234567"},
+		{NumberFormat{Locale: "en_US", Grouping: true, Decimals: 2}, 1234.5, "1,234.50"},
+		{NumberFormat{Locale: "en_US", Decimals: DecimalsShortest}, 0.125, "0.125"},
+		{NumberFormat{Locale: "en_US"}, float32(2.5), "2.50"},
+		{NumberFormat{Locale: "en_US", Grouping: true}, 1234.5, "1,234.50"},
+		{NumberFormat{Locale: "en_US", Decimals: DecimalsNone}, float32(2.5), "2"},
+		{NumberFormat{Locale: "en_US", Decimals: DecimalsNone}, 2.75, "3"},
+		{NumberFormat{Locale: "de_DE.UTF-8", Grouping: true, Decimals: 2}, 1234567.891, "1.234.567,89"},
+		{NumberFormat{Locale: "fr_FR", Grouping: true, Decimals: 1}, -9876.54, "-9 876,5"},
+		{NumberFormat{Locale: "ru", Grouping: true}, uint64(1000000), "1 000 000"},
+		{NumberFormat{Locale: "de_CH", Grouping: true}, int8(100), "100"},
+		{NumberFormat{Locale: "de_CH", Grouping: true}, int32(10000), "10’000"},
+		{NumberFormat{Locale: "xx_YY", Grouping: true}, 10000, "10,000"},
+		{NumberFormat{Locale: "en", Compact: true}, 999, "999"},
+		{NumberFormat{Locale: "en", Compact: true}, 1000, "1K"},
+		{NumberFormat{Locale: "en", Compact: true}, 1234, "1.2K"},
+		{NumberFormat{Locale: "en", Compact: true}, -3400000, "-3.4M"},
+		{NumberFormat{Locale: "en", Compact: true}, 999960, "1M"},
+		{NumberFormat{Locale: "en", Compact: true}, 5.6e9, "5.6B"},
+		{NumberFormat{Locale: "de", Compact: true}, 7.85e12, "7,8T"},
+		{NumberFormat{Locale: "en", Grouping: true}, math.Inf(-1), "-Inf"},
+	}
+	for _, test := range tests {
+		got, _, ok := test.format.format(test.value)
+		if !ok || got != test.want {
+			t.Errorf("%+v.format(%v) = %q, %v; want %q", test.format, test.value, got, ok, test.want)
+		}
+	}
+
+	f := &NumberFormat{Grouping: true}
+	for _, v := range []interface{}{"1234", true, nil, struct{}{}} {
+		if _, _, ok := f.format(v); ok {
+			t.Errorf("format(%#v) formatted a non-number", v)
+		}
+	}
+}
+
+func TestTableNumberFormat(t *testing.T) {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Bytes", "Ratio")
+	table.AddRow("a", 1234567, 0.5)
+	table.AddRow("b", 42, 12.25)
+	table.AddRow("c", "n/a", 1000.25)
+	table.SetNumberFormat(&NumberFormat{Locale: "de_DE", Grouping: true, Decimals: 2})
+	table.SetColumnNumberFormat(&NumberFormat{Locale: "en", Compact: true}, 2)
+	table.SetAlign(AlignDecimal, 2, 3)
+
+	expected := "" +
+		"+------+--------+----------+\n" +
+		"| Name | Bytes  | Ratio    |\n" +
+		"+------+--------+----------+\n" +
+		"| a    |   1.2M |     0,50 |\n" +
+		"| b    |  42    |    12,25 |\n" +
+		"| c    | n/a    | 1.000,25 |\n" +
+		"+------+--------+----------+\n"
+	checkRendersTo(t, table, expected)
+
+	// Only the displayed formats change: CSV is for programs.
+	table.SetModeCSV()
+	expected = "" +
+		"Name,Bytes,Ratio\n" +
+		"a,1234567,0.50\n" +
+		"b,42,12.25\n" +
+		"c,n/a,1000.25\n"
+	checkRendersTo(t, table, expected)
+
+	// Column formats follow their columns when others are hidden.
+	table.SetModeTerminal()
+	table.SetColumnNumberFormat(nil, 2)
+	table.SetColumnNumberFormat(&NumberFormat{Locale: "en", Decimals: DecimalsShortest}, 3)
+	if err := table.ShowColumns("Ratio", "Bytes"); err != nil {
+		t.Fatal(err)
+	}
+	expected = "" +
+		"+---------+-----------+\n" +
+		"| Ratio   | Bytes     |\n" +
+		"+---------+-----------+\n" +
+		"|    0.5  | 1.234.567 |\n" +
+		"|   12.25 |        42 |\n" +
+		"| 1000.25 |       n/a |\n" +
+		"+---------+-----------+\n"
+	checkRendersTo(t, table, expected)
+}
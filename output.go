@@ -0,0 +1,87 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"io"
+	"math"
+	"os"
+
+	"github.com/scylladb/termtables/term"
+)
+
+// unlimitedColumns is the width available when writing to something other
+// than a terminal, where lines are never wrapped.
+const unlimitedColumns = math.MaxInt32
+
+// RenderFor returns the table rendered for writing to a specific file, such
+// as os.Stderr, rather than to whichever terminal MaxColumns describes.  In
+// terminal mode, the file itself is inspected, per term.GetCapabilities:
+//
+// If it is a terminal, the table is laid out for its width and height, color
+// escape sequences are kept if the environment allows color, and UTF-8
+// box-drawing characters are used if the locale is UTF-8.
+//
+// If it is not a terminal, lines are never wrapped, so the table is laid out
+// without limit on its width and PageRowsTerminal paging is turned off; color
+// escape sequences are removed unless forced by the environment, and ASCII
+// box-drawing characters are used.
+//
+// A width set with SetSizeProvider is kept, and box-drawing characters are
+// only chosen for tables which use the ASCII or UTF-8 ones, and have not had
+// them chosen by OutputFlags; custom borders are left alone.  Other output
+// modes are the same as Render.
+func (t *Table) RenderFor(file *os.File) string {
+	if t.outputMode != outputTerminal {
+		return t.Render()
+	}
+	return t.forCapabilities(term.GetCapabilities(file), file).Render()
+}
+
+// Fprint writes the table to w, as rendered by RenderFor if w is an *os.File,
+// and otherwise as rendered by RenderFor for a file which is not a terminal.
+func (t *Table) Fprint(w io.Writer) (int, error) {
+	if file, ok := w.(*os.File); ok {
+		return io.WriteString(w, t.RenderFor(file))
+	}
+	if t.outputMode != outputTerminal {
+		return io.WriteString(w, t.Render())
+	}
+	caps := term.GetCapabilities(nil)
+	return io.WriteString(w, t.forCapabilities(caps, nil).Render())
+}
+
+// forCapabilities returns a copy of the table set up for writing to file,
+// which has the given capabilities.
+func (t *Table) forCapabilities(caps term.Capabilities, file *os.File) *Table {
+	tt := t.clone()
+	tt.noColor = caps.Colors == term.ColorNone
+	tt.color = !tt.noColor
+
+	if !tt.fixedBorders && tt.Style.isStockBoxStyle() {
+		style := *tt.Style
+		if caps.TTY && caps.UTF8 {
+			style.setUtfBoxStyle()
+		} else {
+			style.setAsciiBoxStyle()
+		}
+		tt.Style = &style
+	}
+
+	if !caps.TTY {
+		if tt.sizeProvider == nil {
+			tt.sizeProvider = func() int { return unlimitedColumns }
+		}
+		if tt.pageRows == PageRowsTerminal {
+			tt.pageRows = 0
+		}
+		return tt
+	}
+	if size, err := term.GetTerminalWindowSize(file); err == nil {
+		if tt.sizeProvider == nil {
+			tt.sizeProvider = func() int { return size.Columns }
+		}
+		tt.terminalLines = size.Lines
+	}
+	return tt
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func createColorTable() *Table {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.UTF8Box()
+	table.AddHeaders("Name", "Status", "Description")
+	table.AddRow("db1", "\033[32mup\033[0m", "primary database server")
+	table.SetExpanded(ExpandedAuto)
+	return table
+}
+
+func TestRenderForFile(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	saved := MaxColumns
+	defer func() { MaxColumns = saved }()
+	MaxColumns = 20
+
+	f, err := ioutil.TempFile("", "termtables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	expected := "" +
+		"+------+--------+-------------------------+\n" +
+		"| Name | Status | Description             |\n" +
+		"+------+--------+-------------------------+\n" +
+		"| db1  | up     | primary database server |\n" +
+		"+------+--------+-------------------------+\n"
+
+	table := createColorTable()
+	if output := table.RenderFor(f); output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+
+	// Writing to other than a file is the same as to a file which is not a
+	// terminal.
+	var b bytes.Buffer
+	if _, err := table.Fprint(&b); err != nil {
+		t.Fatal(err)
+	}
+	if output := b.String(); output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+
+	// The table itself is unchanged.
+	expected = "" +
+		"╭─────────────┬─────────────────────────╮\n" +
+		"│ Name        │ db1                     │\n" +
+		"│ Status      │ \033[32mup\033[0m                      │\n" +
+		"│ Description │ primary database server │\n" +
+		"╰─────────────┴─────────────────────────╯\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestRenderForCustomBorders(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	table := CreateTable()
+	table.Style = &TableStyle{
+		BorderX: "=", BorderY: "!", BorderI: "*",
+		PaddingLeft: 1, PaddingRight: 1,
+		Alignment: AlignLeft,
+	}
+	table.AddHeaders("Name")
+	table.AddRow("db1")
+
+	expected := "" +
+		"*======*\n" +
+		"! Name !\n" +
+		"*======*\n" +
+		"! db1  !\n" +
+		"*======*\n"
+	var b bytes.Buffer
+	if _, err := table.Fprint(&b); err != nil {
+		t.Fatal(err)
+	}
+	if output := b.String(); output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+}
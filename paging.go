@@ -0,0 +1,157 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bytes"
+
+	"github.com/scylladb/termtables/term"
+)
+
+// PageRowsTerminal can be given to SetPageRows to make each page as long as
+// will fit in the terminal, per term.GetSize, allowing for the title, headers
+// and borders, and for one line of prompt.
+const PageRowsTerminal = -1
+
+// defaultPageLines is the height of terminal assumed by PageRowsTerminal when
+// the size of the terminal can't be found.
+const defaultPageLines = 24
+
+// SetPageRows controls whether a table is split into pages in terminal mode,
+// each holding at most n rows and repeating the title and headers; n may be
+// PageRowsTerminal, and 0 turns paging off.  All pages use the same column
+// widths.  Render returns all of the pages, separated by blank lines; use
+// Pages to step through them one at a time.
+func (t *Table) SetPageRows(n int) {
+	t.pageRows = n
+}
+
+// PageIterator steps through the pages of a table; see Table.Pages.
+type PageIterator struct {
+	pages []*Table
+	index int
+}
+
+// Pages returns an iterator over the pages of the table, per SetPageRows; a
+// table without paging has one page.  Call Next before each page, including
+// the first:
+//
+//	pages := table.Pages()
+//	for pages.Next() {
+//		fmt.Print(pages.Render())
+//	}
+func (t *Table) Pages() *PageIterator {
+	return &PageIterator{pages: t.withDisplayValues().pages(), index: -1}
+}
+
+// Next advances to the next page, returning false if there are no more.
+func (p *PageIterator) Next() bool {
+	if p.index < len(p.pages) {
+		p.index++
+	}
+	return p.index < len(p.pages)
+}
+
+// Number returns the number of the current page, counting from 1.
+func (p *PageIterator) Number() int {
+	return p.index + 1
+}
+
+// Count returns the total number of pages.
+func (p *PageIterator) Count() int {
+	return len(p.pages)
+}
+
+// Table returns the current page as a table of its own.
+func (p *PageIterator) Table() *Table {
+	return p.pages[p.index]
+}
+
+// Render returns the current page drawn in terminal mode.
+func (p *PageIterator) Render() string {
+	return p.pages[p.index].renderTerminal()
+}
+
+// pages splits the table into pages, which have their paging turned off and
+// the column widths of the whole table as their minimum widths.
+func (t *Table) pages() []*Table {
+	if t.pageRows == 0 {
+		return []*Table{t}
+	}
+
+	_, full := t.terminalLayout()
+	limit, linesCost := t.pageRows, 0
+	if limit == PageRowsTerminal {
+		lines := defaultPageLines
+		if t.terminalLines > 0 {
+			lines = t.terminalLines
+		} else if sz, err := term.GetSize(); err == nil && sz.Lines > 0 {
+			lines = sz.Lines
+		}
+		empty := t.clone()
+		empty.elements = nil
+		overhead, _ := empty.terminalLayout()
+		limit = lines - len(overhead.elements) - 2
+		linesCost = 1
+	}
+	if limit < 1 {
+		limit = 1
+	}
+
+	pages := []*Table{}
+	var current []Element
+	used := 0
+	flush := func() {
+		// Separators at the edges of a page would double up with borders.
+		for len(current) > 0 && !isRow(current[len(current)-1]) {
+			current = current[:len(current)-1]
+		}
+		page := t.clone()
+		page.elements = current
+		page.pageRows = 0
+		page.minWidths = full.cellWidths
+		pages = append(pages, page)
+		current, used = nil, 0
+	}
+	for _, e := range t.elements {
+		cost := linesCost
+		if isRow(e) {
+			cost = 1
+			if linesCost > 0 {
+				cost = e.(*Row).height()
+			}
+		} else if len(current) == 0 {
+			continue
+		}
+		if used+cost > limit && used > 0 {
+			flush()
+			if !isRow(e) {
+				continue
+			}
+		}
+		current = append(current, e)
+		used += cost
+	}
+	if len(current) > 0 || len(pages) == 0 {
+		flush()
+	}
+	return pages
+}
+
+// isRow reports whether an element is a row of cells, rather than a rule.
+func isRow(e Element) bool {
+	_, ok := e.(*Row)
+	return ok
+}
+
+// renderPages returns all pages of the table, separated by blank lines.
+func (t *Table) renderPages() string {
+	b := bytes.NewBuffer(nil)
+	for i, page := range t.pages() {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(page.renderTerminal())
+	}
+	return b.String()
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestPageRows(t *testing.T) {
+	expected := "" +
+		"+------------------+\n" +
+		"|       Log        |\n" +
+		"+-------+----------+\n" +
+		"| Level | Message  |\n" +
+		"+-------+----------+\n" +
+		"| INFO  | start    |\n" +
+		"| WARN  | slow     |\n" +
+		"+-------+----------+\n" +
+		"\n" +
+		"+------------------+\n" +
+		"|       Log        |\n" +
+		"+-------+----------+\n" +
+		"| Level | Message  |\n" +
+		"+-------+----------+\n" +
+		"| ERROR | too slow |\n" +
+		"+-------+----------+\n"
+
+	table := CreateTable()
+	table.AddTitle("Log")
+	table.AddHeaders("Level", "Message")
+	table.AddRow("INFO", "start")
+	table.AddRow("WARN", "slow")
+	table.AddSeparator()
+	table.AddRow("ERROR", "too slow")
+	table.SetPageRows(2)
+
+	checkRendersTo(t, table, expected)
+
+	pages := table.Pages()
+	if pages.Count() != 2 {
+		t.Fatal("Expected 2 pages, got", pages.Count())
+	}
+	rendered := ""
+	for pages.Next() {
+		if pages.Number() > 1 {
+			rendered += "\n"
+		}
+		rendered += pages.Render()
+	}
+	if rendered != expected {
+		t.Fatal(DisplayFailedOutput(rendered, expected))
+	}
+	if pages.Next() {
+		t.Fatal("Iterator did not stop after the last page")
+	}
+}
+
+func TestPagesWithoutPaging(t *testing.T) {
+	table := CreateTable()
+	table.AddRow("a")
+	pages := table.Pages()
+	if !pages.Next() || pages.Render() != table.Render() || pages.Next() {
+		t.Fatal("Table without paging should have exactly one page")
+	}
+}
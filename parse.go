@@ -0,0 +1,612 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"html"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// ErrNoTable is returned by the Parse functions when the input does not
+// contain a table which they can recognize.
+var ErrNoTable = errors.New("termtables: no table found")
+
+// The values of cells in parsed tables are always strings; no attempt is made
+// to recover numbers, as the formatting of the string is what matters for
+// rendering the table again.
+
+// newParsedTable returns a Table with its own copy of the default style, so
+// that details recovered from the input do not alter other tables.
+func newParsedTable() *Table {
+	t := CreateTable()
+	style := *t.Style
+	t.Style = &style
+	return t
+}
+
+// ParseTerminal reads a table drawn with ASCII or UTF-8 box-drawing
+// characters, such as is produced by Render in terminal mode, skipping any
+// lines before the table and stopping at the first line after it.  The
+// title, headers, separators, column-spanning cells, the box style and the
+// alignment of cells narrower than their column are all recovered, so that
+// rendering a table produced by this package gives the same output again.
+//
+// Headers can't be told apart from a first row followed by a separator,
+// and a title can't be told apart from a first row in a table with only one
+// column; both are ambiguous only in ways which render the same.
+func ParseTerminal(r io.Reader) (*Table, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \r")
+		if isTerminalRule(line) || isTerminalRow(line) {
+			lines = append(lines, line)
+		} else if len(lines) > 0 {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, ErrNoTable
+	}
+
+	t := newParsedTable()
+	if strings.ContainsAny(strings.Join(lines, ""), "─│") {
+		t.Style.setUtfBoxStyle()
+	} else {
+		t.Style.setAsciiBoxStyle()
+	}
+	t.Style.SkipBorder = !isTerminalRule(lines[len(lines)-1])
+
+	boundaries := terminalColumnBoundaries(lines)
+	columns := len(boundaries) + 1
+
+	// Drop the outer lines, then peel off the title and headers, which are
+	// recognizable by the rule lines following them.
+	if !t.Style.SkipBorder {
+		lines = lines[:len(lines)-1]
+	}
+	hadTop := len(lines) > 0 && isTerminalRule(lines[0])
+	if hadTop {
+		lines = lines[1:]
+	}
+	if len(lines) > 0 && isTerminalRow(lines[0]) && columns > 1 {
+		if cells := splitTerminalRow(lines[0], boundaries); len(cells) == 1 {
+			t.AddTitle(cells[0].formattedValue)
+			lines = lines[1:]
+			if len(lines) > 0 && isTerminalRule(lines[0]) {
+				lines = lines[1:]
+				if t.Style.SkipBorder && len(lines) > 0 && isTerminalRow(lines[0]) {
+					// Without borders, the rule after a title is only
+					// drawn to go above headers.
+					t.AddHeaders(cellsAsItems(splitTerminalRow(lines[0], boundaries))...)
+					lines = lines[1:]
+				}
+			}
+		}
+	}
+	if t.headers == nil && len(lines) > 2 && isTerminalRow(lines[0]) && isTerminalRule(lines[1]) {
+		t.AddHeaders(cellsAsItems(splitTerminalRow(lines[0], boundaries))...)
+		lines = lines[2:]
+	}
+
+	for _, line := range lines {
+		if isTerminalRule(line) {
+			t.AddSeparator()
+		} else {
+			t.AddRow(cellsAsItems(splitTerminalRow(line, boundaries))...)
+		}
+	}
+	return t, nil
+}
+
+const (
+	terminalRuleRunes = "-+─┼┬┴├┤╭╮╰╯┌┐└┘"
+	terminalLineRunes = "-─"
+	terminalBarRunes  = "|│"
+)
+
+// isTerminalRule reports whether line is a horizontal rule of a table.
+func isTerminalRule(line string) bool {
+	if !strings.ContainsAny(line, terminalLineRunes) || !hasTerminalEdges(line) {
+		return false
+	}
+	for _, r := range line {
+		if !strings.ContainsRune(terminalRuleRunes, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isTerminalRow reports whether line is a row of cells of a table.
+func isTerminalRow(line string) bool {
+	return (strings.HasPrefix(line, "|") || strings.HasPrefix(line, "│")) && hasTerminalEdges(line)
+}
+
+// hasTerminalEdges reports whether line is long enough to have both a left
+// and a right edge, as every line of a table has.
+func hasTerminalEdges(line string) bool {
+	return utf8.RuneCountInString(filterColorCodes(line)) >= 2
+}
+
+// terminalUnit is a rune, or a color escape sequence, of a line of a table,
+// together with the display column at which it starts.
+type terminalUnit struct {
+	text   string
+	column int
+}
+
+// terminalUnits splits a line into units, tracking display columns.
+func terminalUnits(line string) []terminalUnit {
+	escapes := colorFilter.FindAllStringIndex(line, -1)
+	units := make([]terminalUnit, 0, len(line))
+	column := 0
+	for i := 0; i < len(line); {
+		if len(escapes) > 0 && escapes[0][0] == i {
+			units = append(units, terminalUnit{text: line[i:escapes[0][1]], column: column})
+			i = escapes[0][1]
+			escapes = escapes[1:]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(line[i:])
+		units = append(units, terminalUnit{text: line[i : i+size], column: column})
+		column += runewidth.RuneWidth(r)
+		i += size
+	}
+	return units
+}
+
+// terminalColumnBoundaries returns the display columns of the interior
+// vertical borders of a table, as shown by the junctions in its rules; a
+// table with no such rules has no fixed boundaries, so rows are split at
+// every vertical bar.
+func terminalColumnBoundaries(lines []string) []int {
+	var best []int
+	for _, line := range lines {
+		if !isTerminalRule(line) {
+			continue
+		}
+		units := terminalUnits(line)
+		if len(units) < 2 {
+			continue
+		}
+		junctions := []int{}
+		for _, u := range units[1 : len(units)-1] {
+			if !strings.Contains(terminalLineRunes, u.text) {
+				junctions = append(junctions, u.column)
+			}
+		}
+		if len(junctions) > len(best) {
+			best = junctions
+		}
+	}
+	if best == nil {
+		for _, line := range lines {
+			if !isTerminalRow(line) {
+				continue
+			}
+			units := terminalUnits(line)
+			if len(units) < 2 {
+				continue
+			}
+			junctions := []int{}
+			for _, u := range units[1 : len(units)-1] {
+				if strings.Contains(terminalBarRunes, u.text) {
+					junctions = append(junctions, u.column)
+				}
+			}
+			if len(junctions) > len(best) {
+				best = junctions
+			}
+		}
+	}
+	return best
+}
+
+// splitTerminalRow returns the cells of one row, split at those boundaries
+// where the row has a vertical bar; a cell which continues across a boundary
+// is column-spanning.
+func splitTerminalRow(line string, boundaries []int) []*Cell {
+	units := terminalUnits(line)
+	if len(units) < 2 {
+		return nil
+	}
+	units = units[1 : len(units)-1]
+	cells := []*Cell{}
+	current := ""
+	span := 1
+	next := 0
+	for _, u := range units {
+		if next < len(boundaries) && u.column == boundaries[next] {
+			next++
+			if strings.Contains(terminalBarRunes, u.text) {
+				cells = append(cells, parsedTerminalCell(current, span))
+				current, span = "", 1
+				continue
+			}
+			span++
+		}
+		current += u.text
+	}
+	// Boundaries beyond the end of a short row still count towards the span.
+	return append(cells, parsedTerminalCell(current, span+len(boundaries)-next))
+}
+
+// parsedTerminalCell creates a cell from its drawn content, which includes
+// the padding; the alignment is recovered from any further spaces.
+func parsedTerminalCell(drawn string, span int) *Cell {
+	content := strings.TrimPrefix(drawn, strings.Repeat(" ", DefaultStyle.PaddingLeft))
+	content = strings.TrimSuffix(content, strings.Repeat(" ", DefaultStyle.PaddingRight))
+	value := strings.Trim(content, " ")
+	lead := len(content) - len(strings.TrimLeft(content, " "))
+	trail := len(content) - len(strings.TrimRight(content, " "))
+
+	style := &CellStyle{ColSpan: span}
+	switch {
+	case value == "":
+		style = nil
+	case lead > 0 && trail > 0:
+		style.Alignment = AlignCenter
+	case lead > 0:
+		style.Alignment = AlignRight
+	case span == 1:
+		style = nil
+	}
+	if style != nil && span == 1 {
+		style.ColSpan = 0
+	}
+	return CreateCell(value, style)
+}
+
+// cellsAsItems converts cells into items for AddRow and AddHeaders; cells
+// which need no style are replaced by their value, so that they pick up the
+// alignment of the table.
+func cellsAsItems(cells []*Cell) []interface{} {
+	items := make([]interface{}, len(cells))
+	for i, c := range cells {
+		if c.alignment == nil && c.colSpan == 1 {
+			items[i] = c.formattedValue
+		} else {
+			items[i] = c
+		}
+	}
+	return items
+}
+
+// markdownDelimiter matches a cell of the line between the headers and the
+// body of a GitHub Flavored Markdown table.
+var markdownDelimiter = regexp.MustCompile(`^\s*:?-+:?\s*$`)
+
+// ParseMarkdown reads a GitHub Flavored Markdown table, such as is produced
+// by Render in Markdown mode, including a preceding "Table: " title line.
+// The alignment given in the delimiter row is applied to the body cells.
+func ParseMarkdown(r io.Reader) (*Table, error) {
+	var title string
+	rows := [][]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "|") {
+			rows = append(rows, splitMarkdownRow(line))
+			continue
+		}
+		if len(rows) > 0 {
+			break
+		}
+		if strings.HasPrefix(line, "Table: ") {
+			title = strings.TrimPrefix(line, "Table: ")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(rows) < 2 {
+		return nil, ErrNoTable
+	}
+	aligns := make([]TableAlignment, len(rows[1]))
+	for i, d := range rows[1] {
+		if !markdownDelimiter.MatchString(d) {
+			return nil, ErrNoTable
+		}
+		d = strings.TrimSpace(d)
+		left, right := strings.HasPrefix(d, ":"), strings.HasSuffix(d, ":")
+		switch {
+		case left && right:
+			aligns[i] = AlignCenter
+		case right:
+			aligns[i] = AlignRight
+		case left:
+			aligns[i] = AlignLeft
+		}
+	}
+
+	t := newParsedTable()
+	t.Style.setAsciiBoxStyle()
+	if title != "" {
+		t.AddTitle(title)
+	}
+	headers := rows[0]
+	empty := true
+	for _, h := range headers {
+		empty = empty && h == ""
+	}
+	if !empty {
+		t.AddHeaders(stringsAsItems(headers)...)
+	}
+	for _, row := range rows[2:] {
+		items := stringsAsItems(row)
+		for i := range items {
+			if i < len(aligns) && aligns[i] != 0 {
+				items[i] = CreateCell(row[i], &CellStyle{Alignment: aligns[i]})
+			}
+		}
+		t.AddRow(items...)
+	}
+	return t, nil
+}
+
+// markdownUnescaper undoes the escaping of vertical bars in Markdown cells.
+var markdownUnescaper = strings.NewReplacer(`\|`, "|", "&#x7c;", "|", "&#124;", "|")
+
+// splitMarkdownRow returns the trimmed cells of one Markdown table row.
+func splitMarkdownRow(line string) []string {
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+	cells := []string{}
+	current := ""
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			current += `\|`
+			i++
+		case line[i] == '|':
+			cells = append(cells, current)
+			current = ""
+		default:
+			current += line[i : i+1]
+		}
+	}
+	cells = append(cells, current)
+	for i := range cells {
+		cells[i] = markdownUnescaper.Replace(strings.TrimSpace(cells[i]))
+	}
+	return cells
+}
+
+// stringsAsItems converts strings into items for AddRow and AddHeaders.
+func stringsAsItems(values []string) []interface{} {
+	items := make([]interface{}, len(values))
+	for i := range values {
+		items[i] = values[i]
+	}
+	return items
+}
+
+// ParseCSV reads comma-separated values, or other delimiter-separated values
+// such as TSV if comma is '\t', into a table; if headers is true, the first
+// record is used as the headers.  Records may have differing numbers of
+// fields.
+func ParseCSV(r io.Reader, comma rune, headers bool) (*Table, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	if comma == '\t' {
+		reader.LazyQuotes = true
+	}
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrNoTable
+	}
+
+	t := newParsedTable()
+	if headers {
+		t.AddHeaders(stringsAsItems(records[0])...)
+		records = records[1:]
+	}
+	for _, record := range records {
+		t.AddRow(stringsAsItems(record)...)
+	}
+	return t, nil
+}
+
+var (
+	htmlTag       = regexp.MustCompile(`(?is)<(/?)([a-z][a-z0-9]*)((?:[^>"']|"[^"]*"|'[^']*')*)>`)
+	htmlAttribute = regexp.MustCompile(`(?is)([a-z-]+)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+)
+
+// htmlCell is a cell of a parsed HTML table row.
+type htmlCell struct {
+	text    string
+	tag     string
+	colSpan int
+	align   TableAlignment
+}
+
+// ParseHTML reads the first table element of a simple HTML document or
+// fragment, such as is produced by RenderHTML.  A caption, or a leading
+// header row with one cell spanning all columns, becomes the title; the last
+// header row becomes the headers; each further tbody element is preceded by
+// a separator.  Markup within cells is discarded.  Nested tables are not
+// supported.
+func ParseHTML(r io.Reader) (*Table, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	doc := string(data)
+
+	var (
+		inTable, inHead         bool
+		caption                 *string
+		headRows, bodyRows      [][]htmlCell
+		bodyGroups              []int
+		row                     []htmlCell
+		cell                    *htmlCell
+		content                 string
+		tbodies                 int
+		captionStart, cellStart int
+	)
+	for _, m := range htmlTag.FindAllStringSubmatchIndex(doc, -1) {
+		closing := m[3] > m[2]
+		name := strings.ToLower(doc[m[4]:m[5]])
+		attrs := doc[m[6]:m[7]]
+		if !inTable {
+			if name == "table" && !closing {
+				inTable = true
+			}
+			continue
+		}
+		if cell != nil {
+			content += doc[cellStart:m[0]]
+			cellStart = m[1]
+		}
+		switch {
+		case name == "table" && closing:
+			inTable = false
+		case name == "caption" && !closing:
+			captionStart = m[1]
+		case name == "caption" && closing:
+			text := strings.TrimSpace(html.UnescapeString(htmlTag.ReplaceAllString(doc[captionStart:m[0]], "")))
+			caption = &text
+		case name == "thead":
+			inHead = !closing
+		case name == "tbody" && !closing:
+			tbodies++
+		case name == "tr" && !closing:
+			row = []htmlCell{}
+		case (name == "td" || name == "th") && !closing:
+			cell = parseHTMLCellAttributes(name, attrs)
+			content, cellStart = "", m[1]
+		case (name == "td" || name == "th") && closing && cell != nil:
+			cell.text = strings.TrimSpace(html.UnescapeString(content))
+			row = append(row, *cell)
+			cell = nil
+		case name == "tr" && closing:
+			if cell != nil {
+				cell.text = strings.TrimSpace(html.UnescapeString(content))
+				row = append(row, *cell)
+				cell = nil
+			}
+			if inHead || (len(bodyRows) == 0 && tbodies == 0 && allHeaderCells(row)) {
+				headRows = append(headRows, row)
+			} else {
+				bodyRows = append(bodyRows, row)
+				bodyGroups = append(bodyGroups, tbodies)
+			}
+		}
+		if !inTable {
+			break
+		}
+	}
+	if headRows == nil && bodyRows == nil && caption == nil {
+		return nil, ErrNoTable
+	}
+
+	t := newParsedTable()
+	t.Style.setAsciiBoxStyle()
+	if caption != nil {
+		t.AddTitle(*caption)
+		t.SetHTMLStyleTitle(TitleAsCaption)
+	}
+	if len(headRows) > 1 && len(headRows[0]) == 1 && headRows[0][0].colSpan > 1 && caption == nil {
+		t.AddTitle(headRows[0][0].text)
+		t.SetHTMLStyleTitle(TitleAsThSpan)
+		headRows = headRows[1:]
+	}
+	if len(headRows) > 0 {
+		t.AddHeaders(htmlCellsAsItems(headRows[len(headRows)-1])...)
+	}
+	for i, row := range bodyRows {
+		if i > 0 && bodyGroups[i] != bodyGroups[i-1] {
+			t.AddSeparator()
+		}
+		t.AddRow(htmlCellsAsItems(row)...)
+	}
+	return t, nil
+}
+
+// parseHTMLCellAttributes returns a cell with the span and alignment given
+// by the attributes of a th or td element.
+func parseHTMLCellAttributes(tag, attrs string) *htmlCell {
+	cell := &htmlCell{tag: tag, colSpan: 1}
+	for _, a := range htmlAttribute.FindAllStringSubmatch(attrs, -1) {
+		value := html.UnescapeString(a[2] + a[3] + a[4])
+		switch strings.ToLower(a[1]) {
+		case "colspan":
+			if n, err := strconv.Atoi(value); err == nil && n > 1 {
+				cell.colSpan = n
+			}
+		case "align":
+			cell.align = htmlAlignmentNamed(value)
+		case "style":
+			for _, decl := range strings.Split(value, ";") {
+				kv := strings.SplitN(decl, ":", 2)
+				if len(kv) == 2 && strings.TrimSpace(kv[0]) == "text-align" {
+					cell.align = htmlAlignmentNamed(strings.TrimSpace(kv[1]))
+				}
+			}
+		case "class":
+			for _, class := range strings.Fields(value) {
+				if strings.HasPrefix(class, "align-") {
+					cell.align = htmlAlignmentNamed(strings.TrimPrefix(class, "align-"))
+				}
+			}
+		}
+	}
+	return cell
+}
+
+// htmlAlignmentNamed returns the alignment for an HTML alignment keyword.
+func htmlAlignmentNamed(name string) TableAlignment {
+	switch strings.ToLower(name) {
+	case "left":
+		return AlignLeft
+	case "center":
+		return AlignCenter
+	case "right":
+		return AlignRight
+	}
+	return 0
+}
+
+// allHeaderCells reports whether every cell of a row is a th element.
+func allHeaderCells(row []htmlCell) bool {
+	for _, c := range row {
+		if c.tag != "th" {
+			return false
+		}
+	}
+	return len(row) > 0
+}
+
+// htmlCellsAsItems converts parsed cells into items for AddRow and
+// AddHeaders.
+func htmlCellsAsItems(row []htmlCell) []interface{} {
+	items := make([]interface{}, len(row))
+	for i, c := range row {
+		if c.align == 0 && c.colSpan == 1 {
+			items[i] = c.text
+		} else {
+			items[i] = CreateCell(c.text, &CellStyle{Alignment: c.align, ColSpan: c.colSpan})
+		}
+	}
+	return items
+}
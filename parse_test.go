@@ -0,0 +1,148 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"strings"
+	"testing"
+)
+
+// createParseTestTable returns a table using most features which the parsers
+// should recover.
+func createParseTestTable() *Table {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddTitle("Cluster")
+	table.AddHeaders("Node", "DC", "Load")
+	table.AddRow("10.0.0.1", "dc1", CreateCell("1.5 GB", &CellStyle{Alignment: AlignRight}))
+	table.AddRow("10.0.0.2", CreateCell("dc1", &CellStyle{Alignment: AlignCenter}), "12 KB")
+	table.AddSeparator()
+	table.AddRow(CreateCell("total", &CellStyle{ColSpan: 2}), "1.5 GB")
+	return table
+}
+
+func TestParseTerminalRoundTrip(t *testing.T) {
+	for _, utf8 := range []bool{false, true} {
+		table := createParseTestTable()
+		if utf8 {
+			table.UTF8Box()
+		}
+		expected := table.Render()
+
+		parsed, err := ParseTerminal(strings.NewReader("some preamble\n" + expected + "trailer\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkRendersTo(t, parsed, expected)
+	}
+}
+
+func TestParseTerminalNoHeaders(t *testing.T) {
+	table := CreateTable()
+	table.AddRow("a", "b")
+	table.AddRow("c", CreateCell("d", &CellStyle{Alignment: AlignRight}))
+	expected := table.Render()
+
+	parsed, err := ParseTerminal(strings.NewReader(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.headers != nil || parsed.title != nil {
+		t.Fatal("Unexpected headers or title parsed")
+	}
+	checkRendersTo(t, parsed, expected)
+}
+
+func TestParseMarkdownRoundTrip(t *testing.T) {
+	table := CreateTable()
+	table.SetModeMarkdown()
+	table.AddTitle("Cluster")
+	table.AddHeaders("Node", "Flags")
+	table.AddRow("10.0.0.1", "a|b")
+	table.AddRow("10.0.0.2", "")
+	expected := table.Render()
+
+	parsed, err := ParseMarkdown(strings.NewReader(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed.SetModeMarkdown()
+	checkRendersTo(t, parsed, expected)
+}
+
+func TestParseMarkdownAlignment(t *testing.T) {
+	parsed, err := ParseMarkdown(strings.NewReader("| a | b |\n|---|--:|\n| x | 1 |\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "" +
+		"+---+---+\n" +
+		"| a | b |\n" +
+		"+---+---+\n" +
+		"| x | 1 |\n" +
+		"+---+---+\n"
+	checkRendersTo(t, parsed, expected)
+	if a := parsed.elements[0].(*Row).cells[1].alignment; a == nil || *a != AlignRight {
+		t.Fatal("Alignment not recovered from delimiter row")
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	parsed, err := ParseCSV(strings.NewReader("Name\tValue\nhey\tyou\nken\t1234\n"), '\t', true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "" +
+		"+------+-------+\n" +
+		"| Name | Value |\n" +
+		"+------+-------+\n" +
+		"| hey  | you   |\n" +
+		"| ken  | 1234  |\n" +
+		"+------+-------+\n"
+	checkRendersTo(t, parsed, expected)
+
+	table := createParseTestTable()
+	table.SetModeCSV()
+	expected = table.Render()
+	parsed, err = ParseCSV(strings.NewReader(expected), ',', true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed.SetModeCSV()
+	checkRendersTo(t, parsed, expected)
+}
+
+func TestParseHTMLRoundTrip(t *testing.T) {
+	for _, title := range []titleStyle{TitleAsCaption, TitleAsThSpan} {
+		table := createParseTestTable()
+		table.SetModeHTML()
+		table.SetHTMLStyleTitle(title)
+		expected := table.Render()
+
+		parsed, err := ParseHTML(strings.NewReader("<p>Report</p>\n" + expected))
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed.SetModeHTML()
+		checkRendersTo(t, parsed, expected)
+	}
+}
+
+func TestParseNoTable(t *testing.T) {
+	if _, err := ParseTerminal(strings.NewReader("nothing here\n")); err != ErrNoTable {
+		t.Error("Expected ErrNoTable from ParseTerminal, got", err)
+	}
+	// Lines too short to have two edges are not part of a table.
+	for _, input := range []string{"-\n", "|\n", "│\n", "-\n|\n-\n"} {
+		if _, err := ParseTerminal(strings.NewReader(input)); err != ErrNoTable {
+			t.Errorf("Expected ErrNoTable from ParseTerminal for %q, got %v", input, err)
+		}
+	}
+	if _, err := ParseMarkdown(strings.NewReader("| just one line |\n")); err != ErrNoTable {
+		t.Error("Expected ErrNoTable from ParseMarkdown, got", err)
+	}
+	if _, err := ParseHTML(strings.NewReader("<p>no table</p>")); err != ErrNoTable {
+		t.Error("Expected ErrNoTable from ParseHTML, got", err)
+	}
+}
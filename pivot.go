@@ -0,0 +1,162 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import "sort"
+
+// TotalLabel heads the column of row totals, and starts the row of column
+// totals, of tables made by a Pivot.
+const TotalLabel = "Total"
+
+// PivotOptions controls how a Pivot builds a table.
+type PivotOptions struct {
+	// RowHeader is the header of the first column, holding the row keys.
+	RowHeader string
+
+	// SortRows and SortColumns sort the rows and columns by their keys, as
+	// SortBy sorts rows; otherwise they are in the order first seen.
+	SortRows    bool
+	SortColumns bool
+
+	// Aggregate combines the values added for the same row and column; if
+	// nil, AggregateSum is used.  A single value is shown as it is.
+	Aggregate Aggregate
+
+	// RowTotals adds a column holding the total of each row, and
+	// ColumnTotals a row holding the total of each column, computed by
+	// AggregateSum.  The row of column totals is treated as GroupBy treats
+	// subtotal rows: it is left out of CSV and JSON output, and stays last
+	// when rows are sorted.
+	RowTotals    bool
+	ColumnTotals bool
+
+	// Missing is shown where no value was added for a row and column; see
+	// SetEmptyPlaceholder.
+	Missing string
+}
+
+// A Pivot turns long-form records, each a row key, a column key and a
+// value, such as a node, a metric and its value, into a table with one row
+// per row key and one column per column key, in the manner of a
+// spreadsheet's pivot table or crosstab.
+type Pivot struct {
+	opts    PivotOptions
+	rows    []interface{}
+	columns []interface{}
+	rowAt   map[string]int
+	colAt   map[string]int
+	values  map[[2]int][]interface{}
+}
+
+// NewPivot returns an empty Pivot, which builds tables as controlled by
+// opts, which may be nil.
+func NewPivot(opts *PivotOptions) *Pivot {
+	p := &Pivot{
+		rowAt:  map[string]int{},
+		colAt:  map[string]int{},
+		values: map[[2]int][]interface{}{},
+	}
+	if opts != nil {
+		p.opts = *opts
+	}
+	return p
+}
+
+// Add adds the value for a row key and a column key; keys are the same if
+// they are rendered the same.
+func (p *Pivot) Add(row, column, value interface{}) {
+	r := pivotKey(&p.rows, p.rowAt, row)
+	c := pivotKey(&p.columns, p.colAt, column)
+	p.values[[2]int{r, c}] = append(p.values[[2]int{r, c}], value)
+}
+
+// pivotKey returns the index of a key, adding it to keys if it is new.
+func pivotKey(keys *[]interface{}, at map[string]int, key interface{}) int {
+	name := renderValue(key)
+	if i, ok := at[name]; ok {
+		return i
+	}
+	at[name] = len(*keys)
+	*keys = append(*keys, key)
+	return at[name]
+}
+
+// Table returns a table of the values added so far.
+func (p *Pivot) Table() *Table {
+	rows := pivotOrder(p.rows, p.opts.SortRows)
+	columns := pivotOrder(p.columns, p.opts.SortColumns)
+	aggregate := p.opts.Aggregate
+	if aggregate == nil {
+		aggregate = AggregateSum
+	}
+
+	t := CreateTable()
+	headers := []interface{}{p.opts.RowHeader}
+	for _, c := range columns {
+		headers = append(headers, p.columns[c])
+	}
+	if p.opts.RowTotals {
+		headers = append(headers, TotalLabel)
+	}
+	t.AddHeaders(headers...)
+
+	columnValues := make([][]interface{}, len(columns)+1)
+	for _, r := range rows {
+		items := []interface{}{p.rows[r]}
+		rowValues := []interface{}{}
+		for n, c := range columns {
+			var item interface{}
+			switch values := p.values[[2]int{r, c}]; len(values) {
+			case 0:
+			case 1:
+				item = values[0]
+			default:
+				item = aggregate(values)
+			}
+			items = append(items, item)
+			rowValues = append(rowValues, item)
+			columnValues[n] = append(columnValues[n], item)
+		}
+		if p.opts.RowTotals {
+			total := AggregateSum(rowValues)
+			items = append(items, total)
+			columnValues[len(columns)] = append(columnValues[len(columns)], total)
+		}
+		t.AddRow(items...)
+	}
+
+	if p.opts.ColumnTotals {
+		t.AddSeparator()
+		items := []interface{}{TotalLabel}
+		for n := range columns {
+			items = append(items, AggregateSum(columnValues[n]))
+		}
+		if p.opts.RowTotals {
+			items = append(items, AggregateSum(columnValues[len(columns)]))
+		}
+		t.AddRow(items...).subtotal = true
+	}
+
+	if p.opts.Missing != "" {
+		valueColumns := []int{}
+		for i := 2; i <= len(headers); i++ {
+			valueColumns = append(valueColumns, i)
+		}
+		t.SetColumnEmptyPlaceholder(p.opts.Missing, valueColumns...)
+	}
+	return t
+}
+
+// pivotOrder returns the indices of keys, sorted by key if wanted.
+func pivotOrder(keys []interface{}, sorted bool) []int {
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	if sorted {
+		sort.SliceStable(order, func(i, j int) bool {
+			return cellLess(CreateCell(keys[order[i]], nil), CreateCell(keys[order[j]], nil))
+		})
+	}
+	return order
+}
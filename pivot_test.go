@@ -0,0 +1,86 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func createPivotTestTable(opts *PivotOptions) *Table {
+	p := NewPivot(opts)
+	p.Add("node2", "writes", 7)
+	p.Add("node1", "reads", 10)
+	p.Add("node1", "writes", 3)
+	p.Add("node2", "reads", 4)
+	p.Add("node2", "reads", 5)
+	p.Add("node3", "errors", 1)
+	table := p.Table()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.SetColor(false)
+	return table
+}
+
+func TestPivot(t *testing.T) {
+	table := createPivotTestTable(&PivotOptions{RowHeader: "Node"})
+	expected := "" +
+		"+-------+--------+-------+--------+\n" +
+		"| Node  | writes | reads | errors |\n" +
+		"+-------+--------+-------+--------+\n" +
+		"| node2 | 7      | 9     |        |\n" +
+		"| node1 | 3      | 10    |        |\n" +
+		"| node3 |        |       | 1      |\n" +
+		"+-------+--------+-------+--------+\n"
+	checkRendersTo(t, table, expected)
+
+	table = createPivotTestTable(&PivotOptions{
+		RowHeader:    "Node",
+		SortRows:     true,
+		SortColumns:  true,
+		Aggregate:    AggregateMax,
+		RowTotals:    true,
+		ColumnTotals: true,
+		Missing:      "-",
+	})
+	expected = "" +
+		"+-------+--------+-------+--------+-------+\n" +
+		"| Node  | errors | reads | writes | Total |\n" +
+		"+-------+--------+-------+--------+-------+\n" +
+		"| node1 | -      | 10    | 3      | 13    |\n" +
+		"| node2 | -      | 5     | 7      | 12    |\n" +
+		"| node3 | 1      | -     | -      | 1     |\n" +
+		"+-------+--------+-------+--------+-------+\n" +
+		"| Total | 1      | 15    | 10     | 26    |\n" +
+		"+-------+--------+-------+--------+-------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeCSV()
+	expected = "" +
+		"Node,errors,reads,writes,Total\n" +
+		"node1,,10,3,13\n" +
+		"node2,,5,7,12\n" +
+		"node3,1,,,1\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeTerminal()
+	if err := table.SortBy("Total", false); err != nil {
+		t.Fatal(err)
+	}
+	expected = "" +
+		"+-------+--------+-------+--------+-------+\n" +
+		"| Node  | errors | reads | writes | Total |\n" +
+		"+-------+--------+-------+--------+-------+\n" +
+		"| node3 | 1      | -     | -      | 1     |\n" +
+		"| node2 | -      | 5     | 7      | 12    |\n" +
+		"| node1 | -      | 10    | 3      | 13    |\n" +
+		"+-------+--------+-------+--------+-------+\n" +
+		"| Total | 1      | 15    | 10     | 26    |\n" +
+		"+-------+--------+-------+--------+-------+\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestPivotEmpty(t *testing.T) {
+	table := NewPivot(nil).Table()
+	table.SetModeJSON()
+	checkRendersTo(t, table, "[]\n")
+}
@@ -7,7 +7,12 @@ import "strings"
 // A Row represents one row of a Table, consisting of some number of Cell
 // items.
 type Row struct {
-	cells []*Cell
+	cells       []*Cell
+	class       string
+	header      bool
+	groupHeader bool
+	subtotal    bool
+	level       int
 }
 
 // CreateRow returns a Row where the cells are created as needed to hold each
@@ -32,16 +37,83 @@ func (r *Row) AddCell(item interface{}) {
 	}
 }
 
+// cellColumns returns the column, counting from 0, at which each cell of
+// the row starts, allowing for the columns spanned by the cells before it.
+func (r *Row) cellColumns() []int {
+	columns := make([]int, len(r.cells))
+	column := 0
+	for i, c := range r.cells {
+		columns[i] = column
+		if c.colSpan > 1 {
+			column += c.colSpan
+		} else {
+			column++
+		}
+	}
+	return columns
+}
+
+// SetHTMLClass sets the class attribute used for this row in HTML output.
+func (r *Row) SetHTMLClass(class string) {
+	r.class = class
+}
+
 // Render returns a string representing the content of one row of a table, where
 // the Row contains Cells (not Separators) and the representation includes any
-// vertical borders needed.
+// vertical borders needed.  Cells with content on several lines, such as
+// nested tables, make the row as many lines high as the highest of them,
+// with the content of each cell at the top.
 func (r *Row) Render(style *renderStyle) string {
+	if r.header {
+		r = style.decimalHeaders(r)
+	}
+	height := r.height()
+	if height == 1 {
+		return r.renderLine(r.cells, style)
+	}
+
+	// as Cell.Render does, fill in the table's alignment where none is set
+	for _, c := range r.cells {
+		if c.alignment == nil {
+			c.alignment = &style.Alignment
+		}
+	}
+	lines := make([]string, height)
+	for i := range lines {
+		cells := make([]*Cell, len(r.cells))
+		for j, c := range r.cells {
+			line := *c
+			line.formattedValue = ""
+			if cellLines := c.lines(); i < len(cellLines) {
+				line.formattedValue = cellLines[i]
+			}
+			cells[j] = &line
+		}
+		lines[i] = r.renderLine(cells, style)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderLine returns one line of the row, drawn from cells holding one line
+// each.
+func (r *Row) renderLine(cells []*Cell, style *renderStyle) string {
 	// pre-render and shove into an array... helps with cleanly adding borders
 	renderedCells := []string{}
-	for _, c := range r.cells {
+	for _, c := range cells {
 		renderedCells = append(renderedCells, c.Render(style))
 	}
 
 	// format final output
 	return style.BorderY + strings.Join(renderedCells, style.BorderY) + style.BorderY
 }
+
+// height returns the number of lines the row is drawn on.
+func (r *Row) height() int {
+	height := 1
+	for _, c := range r.cells {
+		if n := strings.Count(c.formattedValue, "\n") + 1; n > height {
+			height = n
+		}
+	}
+	return height
+}
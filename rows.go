@@ -0,0 +1,158 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// numericTypeNames are the database type names, as returned by
+// sql.ColumnType.DatabaseTypeName, of columns holding numbers.
+var numericTypeNames = map[string]bool{
+	"BIGINT": true, "BIGSERIAL": true, "COUNTER": true, "DEC": true,
+	"DECIMAL": true, "DOUBLE": true, "DOUBLE PRECISION": true, "FIXED": true,
+	"FLOAT": true, "FLOAT4": true, "FLOAT8": true, "INT": true, "INT2": true,
+	"INT4": true, "INT8": true, "INTEGER": true, "MEDIUMINT": true,
+	"MONEY": true, "NUMBER": true, "NUMERIC": true, "REAL": true,
+	"SERIAL": true, "SMALLINT": true, "SMALLSERIAL": true, "TINYINT": true,
+	"VARINT": true,
+}
+
+// FromRows creates a table from the result of a database query, with the
+// column names as headers and one row per result row, reading rows until
+// there are none left.  The caller remains responsible for closing rows.
+//
+// Values are scanned into the types reported by the driver where those are
+// sql.Scanners, such as sql.NullString, and are otherwise left as the driver
+// gives them; nulls, including those of the sql.Null types, become nil, and
+// byte slices which are valid UTF-8 become strings.  Columns of numeric
+// database types are right-aligned.
+func FromRows(rows *sql.Rows) (*Table, error) {
+	var t *Table
+	err := StreamRows(rows, 0, func(batch *Table) error {
+		t = batch
+		return nil
+	})
+	return t, err
+}
+
+// StreamRows reads the result of a database query as FromRows does, but
+// calls fn with a table for each batch of up to batchRows rows as soon as
+// they have been read, so that long results can be shown while they are
+// being fetched; all the tables have the same headers and alignments.  If
+// batchRows is zero or less, fn is called once with all the rows, and if
+// there are no rows it is called once with none.  An error returned by fn
+// stops the reading and is returned.
+func StreamRows(rows *sql.Rows, batchRows int, fn func(*Table) error) error {
+	names, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+
+	var numeric []int
+	for i, ct := range types {
+		if isNumericColumnType(ct) {
+			numeric = append(numeric, i+1)
+		}
+	}
+	batch := func() *Table {
+		t := CreateTable()
+		t.AddHeaders(stringsAsItems(names)...)
+		return t
+	}
+	flush := func(t *Table) error {
+		if len(numeric) > 0 {
+			t.SetAlign(AlignRight, numeric...)
+		}
+		return fn(t)
+	}
+
+	t := batch()
+	count, flushed := 0, false
+	for rows.Next() {
+		dest := make([]interface{}, len(types))
+		for i, ct := range types {
+			dest[i] = scanDestination(ct)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		items := make([]interface{}, len(dest))
+		for i, d := range dest {
+			items[i] = rowsValue(reflect.ValueOf(d).Elem().Interface())
+		}
+		t.AddRow(items...)
+
+		count++
+		if batchRows > 0 && count == batchRows {
+			if err := flush(t); err != nil {
+				return err
+			}
+			t = batch()
+			count, flushed = 0, true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if count > 0 || !flushed {
+		return flush(t)
+	}
+	return nil
+}
+
+// scanDestination returns a pointer to scan a value of the column into: to
+// a new value of the column's scan type if that is a sql.Scanner, and
+// otherwise to an interface{}, which takes any value including null.
+func scanDestination(ct *sql.ColumnType) interface{} {
+	if typ := ct.ScanType(); typ != nil && typ.Kind() != reflect.Interface {
+		if reflect.PtrTo(typ).Implements(scannerType) {
+			return reflect.New(typ).Interface()
+		}
+	}
+	return new(interface{})
+}
+
+// rowsValue converts a scanned value to the item for its cell.
+func rowsValue(v interface{}) interface{} {
+	if valuer, ok := v.(driver.Valuer); ok {
+		if value, err := valuer.Value(); err == nil {
+			v = value
+		}
+	}
+	if b, ok := v.([]byte); ok && utf8.Valid(b) {
+		return string(b)
+	}
+	return v
+}
+
+// isNumericColumnType reports whether a column holds numbers, going by the
+// type it scans into, or failing that by its database type name.
+func isNumericColumnType(ct *sql.ColumnType) bool {
+	if typ := ct.ScanType(); typ != nil {
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return true
+		}
+		switch typ {
+		case reflect.TypeOf(sql.NullInt64{}), reflect.TypeOf(sql.NullInt32{}),
+			reflect.TypeOf(sql.NullFloat64{}):
+			return true
+		}
+	}
+	name := strings.ToUpper(ct.DatabaseTypeName())
+	name = strings.TrimPrefix(strings.TrimSuffix(name, " UNSIGNED"), "UNSIGNED ")
+	return numericTypeNames[name]
+}
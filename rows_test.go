@@ -0,0 +1,178 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// rowsTestDriver is a database/sql driver whose queries are keys of
+// rowsTestResults, returning the result stored there.
+type rowsTestDriver struct{}
+
+type rowsTestResult struct {
+	columns   []string
+	scanTypes []reflect.Type
+	dbTypes   []string
+	values    [][]driver.Value
+}
+
+var rowsTestResults = map[string]*rowsTestResult{}
+
+func init() {
+	sql.Register("termtables-test", rowsTestDriver{})
+}
+
+func (rowsTestDriver) Open(name string) (driver.Conn, error) { return rowsTestConn{}, nil }
+
+type rowsTestConn struct{}
+
+func (rowsTestConn) Prepare(query string) (driver.Stmt, error) {
+	result, ok := rowsTestResults[query]
+	if !ok {
+		return nil, errors.New("no such query")
+	}
+	return rowsTestStmt{result}, nil
+}
+func (rowsTestConn) Close() error              { return nil }
+func (rowsTestConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type rowsTestStmt struct{ result *rowsTestResult }
+
+func (s rowsTestStmt) Close() error  { return nil }
+func (s rowsTestStmt) NumInput() int { return 0 }
+func (s rowsTestStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s rowsTestStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &rowsTestRows{result: s.result}, nil
+}
+
+type rowsTestRows struct {
+	result *rowsTestResult
+	next   int
+}
+
+func (r *rowsTestRows) Columns() []string { return r.result.columns }
+func (r *rowsTestRows) Close() error      { return nil }
+func (r *rowsTestRows) Next(dest []driver.Value) error {
+	if r.next == len(r.result.values) {
+		return io.EOF
+	}
+	copy(dest, r.result.values[r.next])
+	r.next++
+	return nil
+}
+func (r *rowsTestRows) ColumnTypeScanType(index int) reflect.Type {
+	return r.result.scanTypes[index]
+}
+func (r *rowsTestRows) ColumnTypeDatabaseTypeName(index int) string {
+	return r.result.dbTypes[index]
+}
+
+func queryRowsTest(t *testing.T, result *rowsTestResult) *sql.Rows {
+	rowsTestResults[t.Name()] = result
+	db, err := sql.Open("termtables-test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	rows, err := db.Query(t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rows.Close() })
+	return rows
+}
+
+var anyType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+func TestFromRows(t *testing.T) {
+	rows := queryRowsTest(t, &rowsTestResult{
+		columns: []string{"id", "name", "price", "data"},
+		scanTypes: []reflect.Type{
+			reflect.TypeOf(int64(0)), reflect.TypeOf(sql.NullString{}), anyType, anyType,
+		},
+		dbTypes: []string{"INTEGER", "TEXT", "decimal", "BLOB"},
+		values: [][]driver.Value{
+			{int64(1), "alpha", []byte("12.50"), []byte{0xff, 0x00}},
+			{int64(20), nil, []byte("3.00"), nil},
+		},
+	})
+
+	table, err := FromRows(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	table.SetEmptyPlaceholder("NULL")
+	table.SetColor(false)
+	expected := "" +
+		"+----+-------+-------+--------+\n" +
+		"| id | name  | price | data   |\n" +
+		"+----+-------+-------+--------+\n" +
+		"|  1 | alpha | 12.50 | 0xff00 |\n" +
+		"| 20 | NULL  |  3.00 | NULL   |\n" +
+		"+----+-------+-------+--------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeJSON()
+	expected = "" +
+		"[\n" +
+		`{"id":1,"name":"alpha","price":"12.50","data":"0xff00"},` + "\n" +
+		`{"id":20,"name":null,"price":"3.00","data":null}` + "\n" +
+		"]\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestStreamRows(t *testing.T) {
+	result := &rowsTestResult{
+		columns:   []string{"n"},
+		scanTypes: []reflect.Type{anyType},
+		dbTypes:   []string{"BIGINT"},
+		values:    [][]driver.Value{{int64(1)}, {int64(2)}, {int64(3)}},
+	}
+
+	var rendered []string
+	err := StreamRows(queryRowsTest(t, result), 2, func(table *Table) error {
+		table.Style = &TableStyle{}
+		*table.Style = *DefaultStyle
+		table.SetModeMarkdown()
+		rendered = append(rendered, table.Render())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"| n |\n| - |\n| 1 |\n| 2 |\n",
+		"| n |\n| - |\n| 3 |\n",
+	}
+	if !reflect.DeepEqual(rendered, expected) {
+		t.Errorf("got %q, expected %q", rendered, expected)
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err = StreamRows(queryRowsTest(t, result), 1, func(table *Table) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Errorf("got %v after %d calls, expected %v after 1", err, calls, stop)
+	}
+
+	result.values = nil
+	calls = 0
+	err = StreamRows(queryRowsTest(t, result), 2, func(table *Table) error {
+		calls++
+		return nil
+	})
+	if err != nil || calls != 1 {
+		t.Errorf("got %v after %d calls for no rows, expected one call", err, calls)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/scylladb/termtables/term"
+)
+
+// A SizeProvider returns the number of columns available for display, and is
+// called each time a table is rendered; a result of 0 or less means that the
+// size isn't known, and MaxColumns is used instead.
+type SizeProvider func() int
+
+// SetSizeProvider sets the source of the width available for this table,
+// which is used instead of MaxColumns when rendering; a nil SizeProvider
+// reverts to MaxColumns.
+func (t *Table) SetSizeProvider(p SizeProvider) {
+	t.sizeProvider = p
+}
+
+// maxColumns returns the number of columns available to the table.
+func (t *Table) maxColumns() int {
+	if t.sizeProvider != nil {
+		if n := t.sizeProvider(); n > 0 {
+			return n
+		}
+	}
+	return MaxColumns
+}
+
+// TerminalSize is a SizeProvider which asks the terminal for its size on
+// every call, per term.GetSize.
+func TerminalSize() int {
+	sz, err := term.GetSize()
+	if err != nil {
+		return 0
+	}
+	return sz.Columns
+}
+
+// WatchTerminalSize returns a SizeProvider which gives the width of the
+// terminal, kept up to date by watching for the terminal being resized, per
+// term.WatchSize, until ctx is done.  This is cheaper than TerminalSize for
+// tables which are rendered often.
+func WatchTerminalSize(ctx context.Context) SizeProvider {
+	var columns int64
+	if sz, err := term.GetSize(); err == nil {
+		columns = int64(sz.Columns)
+	}
+	sizes := term.WatchSize(ctx)
+	go func() {
+		for sz := range sizes {
+			atomic.StoreInt64(&columns, int64(sz.Columns))
+		}
+	}()
+	return func() int {
+		return int(atomic.LoadInt64(&columns))
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestSizeProvider(t *testing.T) {
+	saved := MaxColumns
+	defer func() { MaxColumns = saved }()
+	MaxColumns = 80
+
+	table := CreateTable()
+	table.AddHeaders("Name", "Value")
+	table.AddRow("hey", "you")
+	table.SetExpanded(ExpandedAuto)
+
+	width := 10
+	table.SetSizeProvider(func() int { return width })
+	expected := "" +
+		"+-------+-----+\n" +
+		"| Name  | hey |\n" +
+		"| Value | you |\n" +
+		"+-------+-----+\n"
+	checkRendersTo(t, table, expected)
+
+	// An unknown size falls back to MaxColumns.
+	width = 0
+	expected = "" +
+		"+------+-------+\n" +
+		"| Name | Value |\n" +
+		"+------+-------+\n" +
+		"| hey  | you   |\n" +
+		"+------+-------+\n"
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// SortBy sorts the rows of the table by the named column, matched against
+// the headers of the table.  Values which are numbers, or are rendered as
+// numbers, are compared as numbers and sort before the rest, which are
+// compared as the strings they are rendered as; missing cells sort first.
+// Rows with equal values keep their order, as do separators, with the rows
+// between each pair of separators, or of rows added by GroupBy, sorted
+// separately; in trees of rows, children are sorted among themselves and
+// stay under their parents.  It returns an error, and sorts nothing, if the
+// name does not match a header.
+func (t *Table) SortBy(column string, descending bool) error {
+	indices, err := t.columnIndices([]string{column})
+	if err != nil {
+		return err
+	}
+	t.sortRows(indices[0], descending)
+	return nil
+}
+
+// sortRows sorts the rows of each group between separators by a column.
+func (t *Table) sortRows(column int, descending bool) {
+	less := func(a, b Element) bool {
+		x, y := sortCell(a, column), sortCell(b, column)
+		if descending {
+			x, y = y, x
+		}
+		return cellLess(x, y)
+	}
+	start := 0
+	for i := 0; i <= len(t.elements); i++ {
+		if i < len(t.elements) && isDataRow(t.elements[i]) {
+			continue
+		}
+		sortTree(t.elements[start:i], less)
+		start = i + 1
+	}
+}
+
+// sortCell returns the cell of a row in a column, or nil if there is none.
+func sortCell(e Element, column int) *Cell {
+	row := e.(*Row)
+	for i, start := range row.cellColumns() {
+		if start == column {
+			return row.cells[i]
+		}
+	}
+	return nil
+}
+
+// cellLess reports whether cell a sorts before cell b; missing cells sort
+// first, then numbers, then the rest as strings.
+func cellLess(a, b *Cell) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+	x, xok := cellNumber(a)
+	y, yok := cellNumber(b)
+	switch {
+	case xok && yok:
+		// Integers are compared exactly, as float64 cannot hold all of them.
+		if i, ok := valueInteger(a.value); ok {
+			if j, ok := valueInteger(b.value); ok {
+				return i < j
+			}
+		}
+		return x < y
+	case xok != yok:
+		return xok
+	}
+	return filterColorCodes(a.formattedValue) < filterColorCodes(b.formattedValue)
+}
+
+// cellNumber returns the numeric value of a cell, if it has one; NaN is not
+// taken as a number, as it does not compare with any.
+func cellNumber(c *Cell) (float64, bool) {
+	if f, ok := valueNumber(c.value); ok {
+		return f, !math.IsNaN(f)
+	}
+	f, err := strconv.ParseFloat(filterColorCodes(c.formattedValue), 64)
+	return f, err == nil && !math.IsNaN(f)
+}
+
+// valueNumber returns the numeric value of a value added to a table, if it
+// is a number or a string holding one.
+func valueNumber(value interface{}) (float64, bool) {
+	if i, ok := valueInteger(value); ok {
+		return float64(i), true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// valueInteger returns the value of an integer added to a table, or of a
+// string holding one, as ParseCSV gives, if it fits in an int64.
+func valueInteger(value interface{}) (int64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if u := v.Uint(); u <= math.MaxInt64 {
+			return int64(u), true
+		}
+	case reflect.String:
+		i, err := strconv.ParseInt(v.String(), 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
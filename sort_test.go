@@ -0,0 +1,81 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSortBy(t *testing.T) {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Size")
+	table.AddRow("b", 10)
+	table.AddRow("a", 9)
+	table.AddRow("c", "100")
+	table.AddSeparator()
+	table.AddRow("e", 1)
+	table.AddRow("d")
+
+	if err := table.SortBy("Size", false); err != nil {
+		t.Fatal(err)
+	}
+	expected := "" +
+		"+------+------+\n" +
+		"| Name | Size |\n" +
+		"+------+------+\n" +
+		"| a    | 9    |\n" +
+		"| b    | 10   |\n" +
+		"| c    | 100  |\n" +
+		"+------+------+\n" +
+		"| d    |\n" +
+		"| e    | 1    |\n" +
+		"+------+------+\n"
+	checkRendersTo(t, table, expected)
+
+	if err := table.SortBy("Name", true); err != nil {
+		t.Fatal(err)
+	}
+	expected = "" +
+		"+------+------+\n" +
+		"| Name | Size |\n" +
+		"+------+------+\n" +
+		"| c    | 100  |\n" +
+		"| b    | 10   |\n" +
+		"| a    | 9    |\n" +
+		"+------+------+\n" +
+		"| e    | 1    |\n" +
+		"| d    |\n" +
+		"+------+------+\n"
+	checkRendersTo(t, table, expected)
+
+	if err := table.SortBy("Missing", false); err == nil {
+		t.Error("SortBy of an unknown column succeeded")
+	}
+}
+
+func TestSortByMixedValues(t *testing.T) {
+	table := CreateTable()
+	table.AddHeaders("Name", "Group", "Size")
+	table.AddRow(CreateCell("span", &CellStyle{ColSpan: 2}), "n/a")
+	table.AddRow("big", "x", uint64(math.MaxUint64))
+	table.AddRow("max", "x", int64(math.MaxInt64))
+	table.AddRow("nan", "x", math.NaN())
+	table.AddRow("small", "x", 1)
+	table.SetModeJSON()
+
+	if err := table.SortBy("Size", false); err != nil {
+		t.Fatal(err)
+	}
+	expected := "" +
+		"[\n" +
+		`{"Name":"small","Group":"x","Size":1},` + "\n" +
+		`{"Name":"max","Group":"x","Size":9223372036854775807},` + "\n" +
+		`{"Name":"big","Group":"x","Size":18446744073709551615},` + "\n" +
+		`{"Name":"nan","Group":"x","Size":"NaN"},` + "\n" +
+		`{"Name":"span","Size":"n/a"}` + "\n" +
+		"]\n"
+	checkRendersTo(t, table, expected)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+// SetSplitColumns controls whether a table which is wider than the available
+// columns (see SetSizeProvider) in terminal mode is split into several
+// tables, drawn one after the other, each holding as many of the columns as
+// fit; any key columns, as set by SetKeyColumns, are repeated in every one of
+// them.  Splitting is done after any columns are dropped per SetDropColumns,
+// and takes precedence over ExpandedAuto.
+func (t *Table) SetSplitColumns(onoff bool) {
+	t.splitColumns = onoff
+}
+
+// SetKeyColumns sets the columns, numbered from 1, which identify rows, and
+// so are repeated in every part of a table split per SetSplitColumns.
+func (t *Table) SetKeyColumns(columns ...int) {
+	t.keyColumns = nil
+	for _, column := range columns {
+		if column > 0 {
+			t.keyColumns = append(t.keyColumns, column-1)
+		}
+	}
+}
+
+// columnPages returns the parts of a table which is too wide to draw within
+// maxColumns, holding the key columns and then as many other columns as fit,
+// with at least one other column in each part.  The title is only kept in the
+// first part.
+func (t *Table) columnPages(columns []int, maxColumns int) []*Table {
+	if columns == nil {
+		columns = t.allColumns()
+	}
+	keys := []int{}
+	rest := []int{}
+	for _, i := range columns {
+		isKey := false
+		for _, k := range t.keyColumns {
+			isKey = isKey || i == k
+		}
+		if isKey {
+			keys = append(keys, i)
+		} else {
+			rest = append(rest, i)
+		}
+	}
+	if len(rest) == 0 {
+		return []*Table{t.projectColumns(columns)}
+	}
+
+	pages := []*Table{}
+	for len(rest) > 0 {
+		n := 1
+		page := t.columnPage(keys, rest[:n], len(pages) == 0)
+		for n < len(rest) {
+			next := t.columnPage(keys, rest[:n+1], len(pages) == 0)
+			if _, style := next.terminalLayout(); style.Width > maxColumns {
+				break
+			}
+			page = next
+			n++
+		}
+		pages = append(pages, page)
+		rest = rest[n:]
+	}
+	return pages
+}
+
+// columnPage returns one part of a split table, with the key columns first.
+func (t *Table) columnPage(keys, others []int, withTitle bool) *Table {
+	columns := append(append([]int{}, keys...), others...)
+	page := t.projectColumns(columns)
+	if !withTitle {
+		page.title = nil
+	}
+	return page
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestSplitColumns(t *testing.T) {
+	saved := MaxColumns
+	defer func() { MaxColumns = saved }()
+	MaxColumns = 30
+
+	expected := "" +
+		"+------------------------+\n" +
+		"|         Nodes          |\n" +
+		"+----------+-----+-------+\n" +
+		"| Address  | DC  | Rack  |\n" +
+		"+----------+-----+-------+\n" +
+		"| 10.0.0.1 | dc1 | rack1 |\n" +
+		"| 10.0.0.2 | dc2 | rack2 |\n" +
+		"+----------+-----+-------+\n" +
+		"\n" +
+		"+----------+--------+--------+\n" +
+		"| Address  | Load   | Tokens |\n" +
+		"+----------+--------+--------+\n" +
+		"| 10.0.0.1 | 1.5 GB | 256    |\n" +
+		"| 10.0.0.2 | 12 KB  | 256    |\n" +
+		"+----------+--------+--------+\n"
+
+	table := createColumnsTestTable()
+	table.AddTitle("Nodes")
+	table.SetSplitColumns(true)
+	table.SetKeyColumns(1)
+	checkRendersTo(t, table, expected)
+
+	MaxColumns = 80
+	expected = "" +
+		"+------------------------------------------+\n" +
+		"|                  Nodes                   |\n" +
+		"+----------+-----+-------+--------+--------+\n" +
+		"| Address  | DC  | Rack  | Load   | Tokens |\n" +
+		"+----------+-----+-------+--------+--------+\n" +
+		"| 10.0.0.1 | dc1 | rack1 | 1.5 GB | 256    |\n" +
+		"| 10.0.0.2 | dc2 | rack2 | 12 KB  | 256    |\n" +
+		"+----------+-----+-------+--------+--------+\n"
+	checkRendersTo(t, table, expected)
+}
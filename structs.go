@@ -0,0 +1,265 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrNotStructSlice is returned by FromStructs when it is not given a slice
+// (or array) of structs, or of pointers to structs.
+var ErrNotStructSlice = errors.New("termtables: FromStructs needs a slice of structs")
+
+// StructOptions controls how FromStructs and FromMaps build a table.
+type StructOptions struct {
+	// Columns, if non-empty, selects the columns to include, by header
+	// name, in the order given.
+	Columns []string
+
+	// Tag is the struct tag key holding column options; if empty, "table"
+	// is used.
+	Tag string
+}
+
+// structColumn describes one column of a table built from structs.
+type structColumn struct {
+	header string
+	index  []int
+	align  TableAlignment
+	format string
+	order  int
+}
+
+// FromStructs creates a table from a slice of structs, or of pointers to
+// structs, with one row per element and one column per exported field.
+//
+// Columns are controlled with struct tags of the form
+//
+//	`table:"Header,align=right,format=%.1f,order=2"`
+//
+// where every part is optional: an empty header uses the field name,
+// align is one of left, center or right, format is a fmt verb to use for the
+// value, and order moves the column relative to others (untagged columns
+// have order 0, and ties keep field order).  A tag of "-" or including
+// "omit" leaves the field out.  Fields of embedded structs are included as if
+// they were fields of the outer struct.  Nil pointers, whether elements or
+// fields, give empty cells; values implementing fmt.Stringer, including via
+// a pointer receiver, are rendered with their String method.
+func FromStructs(slice interface{}, opts *StructOptions) (*Table, error) {
+	if opts == nil {
+		opts = &StructOptions{}
+	}
+	tag := opts.Tag
+	if tag == "" {
+		tag = "table"
+	}
+
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, ErrNotStructSlice
+	}
+	elemType := v.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, ErrNotStructSlice
+	}
+
+	columns, err := structColumns(elemType, nil, tag)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(columns, func(i, j int) bool { return columns[i].order < columns[j].order })
+	if len(opts.Columns) > 0 {
+		if columns, err = selectStructColumns(columns, opts.Columns); err != nil {
+			return nil, err
+		}
+	}
+
+	t := CreateTable()
+	headers := make([]interface{}, len(columns))
+	for i, c := range columns {
+		headers[i] = c.header
+	}
+	t.AddHeaders(headers...)
+
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		items := make([]interface{}, len(columns))
+		for j, c := range columns {
+			items[j] = structCell(elem, c)
+		}
+		t.AddRow(items...)
+	}
+	return t, nil
+}
+
+// structColumns returns the columns for the exported fields of a struct
+// type, descending into embedded structs; index is the field index path of
+// the struct within the outermost one.
+func structColumns(typ reflect.Type, index []int, tag string) ([]structColumn, error) {
+	columns := []structColumn{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+		value, tagged := field.Tag.Lookup(tag)
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && !tagged && fieldType.Kind() == reflect.Struct {
+			embedded, err := structColumns(fieldType, fieldIndex, tag)
+			if err != nil {
+				return nil, err
+			}
+			columns = append(columns, embedded...)
+			continue
+		}
+		if field.PkgPath != "" || value == "-" {
+			continue
+		}
+
+		column := structColumn{header: field.Name, index: fieldIndex}
+		omit := false
+		for n, part := range strings.Split(value, ",") {
+			part = strings.TrimSpace(part)
+			switch {
+			case n == 0:
+				if part != "" {
+					column.header = part
+				}
+			case part == "omit":
+				omit = true
+			case strings.HasPrefix(part, "align="):
+				column.align = htmlAlignmentNamed(strings.TrimPrefix(part, "align="))
+				if column.align == 0 {
+					return nil, fmt.Errorf("termtables: field %s: unknown alignment %q", field.Name, part)
+				}
+			case strings.HasPrefix(part, "format="):
+				column.format = strings.TrimPrefix(part, "format=")
+			case strings.HasPrefix(part, "order="):
+				order, err := strconv.Atoi(strings.TrimPrefix(part, "order="))
+				if err != nil {
+					return nil, fmt.Errorf("termtables: field %s: bad order %q", field.Name, part)
+				}
+				column.order = order
+			case part != "":
+				return nil, fmt.Errorf("termtables: field %s: unknown tag option %q", field.Name, part)
+			}
+		}
+		if !omit {
+			columns = append(columns, column)
+		}
+	}
+	return columns, nil
+}
+
+// selectStructColumns returns the named columns, in the order named.
+func selectStructColumns(columns []structColumn, names []string) ([]structColumn, error) {
+	selected := make([]structColumn, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, c := range columns {
+			if c.header == name {
+				selected = append(selected, c)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("termtables: no column %q", name)
+		}
+	}
+	return selected, nil
+}
+
+// structCell returns the item for one column of the row for elem.
+func structCell(elem reflect.Value, c structColumn) interface{} {
+	v := elem
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	for _, i := range c.index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	if !v.CanInterface() {
+		// Only reachable through unexported fields, so not to be shown.
+		return nil
+	}
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		if _, ok := v.Interface().(fmt.Stringer); ok {
+			break
+		}
+		v = v.Elem()
+	}
+
+	var item interface{}
+	if _, ok := v.Interface().(fmt.Stringer); !ok && v.CanAddr() {
+		if s, ok := v.Addr().Interface().(fmt.Stringer); ok {
+			item = s
+		}
+	}
+	if item == nil {
+		item = v.Interface()
+	}
+	if c.format != "" {
+		item = fmt.Sprintf(c.format, item)
+	}
+	if c.align != 0 {
+		return CreateCell(item, &CellStyle{Alignment: c.align})
+	}
+	return item
+}
+
+// FromMaps creates a table from a slice of maps, with one row per map and
+// one column per key found in any of them.  Columns are in sorted order of
+// key, unless chosen with opts.Columns; missing keys and nil values give
+// empty cells, which show any placeholder set with SetEmptyPlaceholder.
+func FromMaps(maps []map[string]interface{}, opts *StructOptions) *Table {
+	if opts == nil {
+		opts = &StructOptions{}
+	}
+	columns := opts.Columns
+	if len(columns) == 0 {
+		seen := map[string]bool{}
+		for _, m := range maps {
+			for k := range m {
+				if !seen[k] {
+					seen[k] = true
+					columns = append(columns, k)
+				}
+			}
+		}
+		sort.Strings(columns)
+	}
+
+	t := CreateTable()
+	t.AddHeaders(stringsAsItems(columns)...)
+	for _, m := range maps {
+		items := make([]interface{}, len(columns))
+		for i, k := range columns {
+			items[i] = m[k]
+		}
+		t.AddRow(items...)
+	}
+	return t
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+type structTestState int
+
+func (s *structTestState) String() string {
+	if *s == 0 {
+		return "DOWN"
+	}
+	return "UP"
+}
+
+type structTestBase struct {
+	DC string `table:"Datacenter,order=1"`
+}
+
+type structTestNode struct {
+	structTestBase
+	Address string
+	Load    float64 `table:",align=right,format=%.1f"`
+	State   structTestState
+	Owner   *string
+	Secret  string `table:"-"`
+	Tokens  int    `table:"Tokens,omit"`
+	private int
+}
+
+func TestFromStructs(t *testing.T) {
+	expected := "" +
+		"+----------+------+-------+-------+------------+\n" +
+		"| Address  | Load | State | Owner | Datacenter |\n" +
+		"+----------+------+-------+-------+------------+\n" +
+		"| 10.0.0.1 | 12.3 | UP    | ops   | dc1        |\n" +
+		"| 10.0.0.2 |  0.5 | DOWN  |       | dc2        |\n" +
+		"+----------+------+-------+-------+------------+\n"
+
+	owner := "ops"
+	nodes := []*structTestNode{
+		{structTestBase{"dc1"}, "10.0.0.1", 12.34, 1, &owner, "x", 1, 0},
+		{structTestBase{"dc2"}, "10.0.0.2", 0.5, 0, nil, "y", 2, 0},
+		nil,
+	}
+	table, err := FromStructs(nodes[:2], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRendersTo(t, table, expected)
+
+	expected = "" +
+		"+------------+----------+\n" +
+		"| Datacenter | Address  |\n" +
+		"+------------+----------+\n" +
+		"| dc1        | 10.0.0.1 |\n" +
+		"| dc2        | 10.0.0.2 |\n" +
+		"|            |          |\n" +
+		"+------------+----------+\n"
+	table, err = FromStructs(nodes, &StructOptions{Columns: []string{"Datacenter", "Address"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRendersTo(t, table, expected)
+}
+
+func TestFromStructsErrors(t *testing.T) {
+	if _, err := FromStructs([]int{1}, nil); err != ErrNotStructSlice {
+		t.Error("Expected ErrNotStructSlice, got", err)
+	}
+	if _, err := FromStructs([]structTestNode{}, &StructOptions{Columns: []string{"Nope"}}); err == nil {
+		t.Error("Expected an error for an unknown column")
+	}
+	type badTag struct {
+		A int `table:",align=sideways"`
+	}
+	if _, err := FromStructs([]badTag{}, nil); err == nil {
+		t.Error("Expected an error for a bad alignment")
+	}
+}
+
+func TestFromMaps(t *testing.T) {
+	expected := "" +
+		"+------+------+-------+\n" +
+		"| host | load | state |\n" +
+		"+------+------+-------+\n" +
+		"| a    | 1    |       |\n" +
+		"| b    |      | UP    |\n" +
+		"+------+------+-------+\n"
+
+	table := FromMaps([]map[string]interface{}{
+		{"host": "a", "load": 1},
+		{"state": "UP", "host": "b", "load": nil},
+	}, nil)
+	checkRendersTo(t, table, expected)
+}
+
+func TestFromMapsNilPlaceholder(t *testing.T) {
+	expected := "" +
+		"+------+------+\n" +
+		"| host | load |\n" +
+		"+------+------+\n" +
+		"| a    | 1    |\n" +
+		"| b    | -    |\n" +
+		"| c    | -    |\n" +
+		"+------+------+\n"
+
+	table := FromMaps([]map[string]interface{}{
+		{"host": "a", "load": 1},
+		{"host": "b", "load": nil},
+		{"host": "c"},
+	}, nil)
+	table.SetEmptyPlaceholder("-")
+	checkRendersTo(t, table, expected)
+}
+
+type structTestInner struct {
+	Zone  string
+	State structTestState
+	hid   int
+}
+
+type structTestOuter struct {
+	*structTestInner
+	Name string
+}
+
+func TestFromStructsEmbeddedPointer(t *testing.T) {
+	expected := "" +
+		"+------+-------+------+\n" +
+		"| Zone | State | Name |\n" +
+		"+------+-------+------+\n" +
+		"| z1   | UP    | a    |\n" +
+		"|      |       | b    |\n" +
+		"+------+-------+------+\n"
+
+	table, err := FromStructs([]structTestOuter{
+		{&structTestInner{"z1", 1, 0}, "a"},
+		{nil, "b"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRendersTo(t, table, expected)
+}
@@ -11,11 +11,17 @@ import (
 type TableAlignment int
 
 // These constants control the alignment which should be used when rendering
-// the content of a cell.
+// the content of a cell.  AlignDecimal lines numbers up on their decimal
+// points, and on any units after them, with the whole right-aligned; headers
+// and cells spanning columns are simply right-aligned.  AlignAuto
+// right-aligns the cells of columns whose values are all numbers, and
+// left-aligns others.
 const (
-	AlignLeft   = TableAlignment(1)
-	AlignCenter = TableAlignment(2)
-	AlignRight  = TableAlignment(3)
+	AlignLeft    = TableAlignment(1)
+	AlignCenter  = TableAlignment(2)
+	AlignRight   = TableAlignment(3)
+	AlignDecimal = TableAlignment(4)
+	AlignAuto    = TableAlignment(5)
 )
 
 // TableStyle controls styling information for a Table as a whole.
@@ -49,6 +55,13 @@ type CellStyle struct {
 
 	// ColSpan indicates how many columns this Cell is expected to consume.
 	ColSpan int
+
+	// RowSpan indicates how many rows this Cell is expected to consume; this
+	// is only honored in HTML output.
+	RowSpan int
+
+	// HTMLClass is used as the class attribute of the Cell in HTML output.
+	HTMLClass string
 }
 
 // DefaultStyle is a TableStyle which can be used to get some simple
@@ -72,6 +85,10 @@ type renderStyle struct {
 	// used for markdown rendering
 	replaceContent func(string) string
 
+	// used for AlignAuto and AlignDecimal
+	numericColumns map[int]bool
+	decimalWidths  map[int]decimalWidths
+
 	TableStyle
 }
 
@@ -101,6 +118,33 @@ func (s *TableStyle) setAsciiBoxStyle() {
 	s.fillStyleRules()
 }
 
+// isStockBoxStyle reports whether the border characters are those set by
+// setUtfBoxStyle or setAsciiBoxStyle, rather than customized.
+func (s *TableStyle) isStockBoxStyle() bool {
+	var utf, ascii TableStyle
+	utf.setUtfBoxStyle()
+	ascii.setAsciiBoxStyle()
+	filled := *s
+	filled.fillStyleRules()
+	return filled.borders() == utf.borders() || filled.borders() == ascii.borders()
+}
+
+// isUTF8Box reports whether the borders are drawn with UTF-8 box-drawing
+// characters rather than ASCII ones.
+func (s *TableStyle) isUTF8Box() bool {
+	return s.BorderY != "" && s.BorderY[0] >= utf8.RuneSelf
+}
+
+// borders returns the border characters of the style, for comparison.
+func (s *TableStyle) borders() [11]string {
+	return [11]string{
+		s.BorderX, s.BorderY, s.BorderI,
+		s.BorderTop, s.BorderBottom, s.BorderLeft, s.BorderRight,
+		s.BorderTopLeft, s.BorderTopRight,
+		s.BorderBottomLeft, s.BorderBottomRight,
+	}
+}
+
 // fillStyleRules populates members of the TableStyle box-drawing specification
 // with BorderI as the default.
 func (s *TableStyle) fillStyleRules() {
@@ -136,6 +180,8 @@ func createRenderStyle(table *Table) *renderStyle {
 
 	if table.outputMode == outputMarkdown {
 		style.buildReplaceContent(table.Style.BorderY)
+	} else if table.outputMode == outputTerminal && table.noColor {
+		style.replaceContent = filterColorCodes
 	}
 
 	// FIXME: handle actually defined width condition
@@ -160,6 +206,14 @@ func createRenderStyle(table *Table) *renderStyle {
 			}
 		}
 	}
+	style.measureAlignments(table)
+
+	// widths carried over from a larger table, such as when paging
+	for i, w := range table.minWidths {
+		if style.cellWidths[i] < w {
+			style.cellWidths[i] = w
+		}
+	}
 	style.columns = len(style.cellWidths)
 
 	// calculate actual width
@@ -177,18 +231,28 @@ func createRenderStyle(table *Table) *renderStyle {
 		width += utf8.RuneCountInString(style.BorderRight) - internalBorderWidth
 	}
 
+	// cells spanning the table, the title and group headers, may widen it
+	spanning := []*Cell{}
 	if table.titleCell != nil {
-		titleMinWidth := 0 +
-			table.titleCell.Width() +
+		spanning = append(spanning, table.titleCell)
+	}
+	for _, element := range table.elements {
+		if row, ok := element.(*Row); ok && row.groupHeader {
+			spanning = append(spanning, row.cells[0])
+		}
+	}
+	for _, cell := range spanning {
+		spanMinWidth := 0 +
+			cell.Width() +
 			utf8.RuneCountInString(style.BorderLeft) +
 			utf8.RuneCountInString(style.BorderRight) +
 			style.PaddingLeft +
 			style.PaddingRight
 
-		if width < titleMinWidth {
-			// minWidth must be set to include padding of the title, as required
-			style.cellWidths[lastIndex] += (titleMinWidth - width)
-			width = titleMinWidth
+		if width < spanMinWidth {
+			// minWidth must be set to include padding of the cell, as required
+			style.cellWidths[lastIndex] += (spanMinWidth - width)
+			width = spanMinWidth
 		}
 	}
 
@@ -205,10 +269,11 @@ func (s *renderStyle) CellWidth(i int) int {
 }
 
 // buildReplaceContent creates a function closure, with minimal bound lexical
-// state, which replaces content
+// state, which replaces content; color escape sequences are removed, as they
+// are meaningless in Markdown.
 func (s *renderStyle) buildReplaceContent(bad string) {
 	replacement := fmt.Sprintf("&#x%02x;", bad)
 	s.replaceContent = func(old string) string {
-		return strings.Replace(old, bad, replacement, -1)
+		return strings.Replace(filterColorCodes(old), bad, replacement, -1)
 	}
 }
@@ -4,9 +4,8 @@ package termtables
 
 import (
 	"bytes"
+	"fmt"
 	"os"
-	"regexp"
-	"runtime"
 	"strings"
 
 	"github.com/scylladb/termtables/term"
@@ -16,7 +15,8 @@ import (
 // display without wrapping around the right-hand side of the terminal window.
 // At program initialization, the value will be automatically set according
 // to available sources of information, including the $COLUMNS environment
-// variable and, on Unix, tty information.
+// variable and, on Unix, tty information.  Tables can instead find the width
+// at the time they are rendered, with SetSizeProvider.
 var MaxColumns = 80
 
 // Element the interface that can draw a representation of the contents of a
@@ -31,6 +31,8 @@ const (
 	outputTerminal outputMode = iota
 	outputMarkdown
 	outputHTML
+	outputCSV
+	outputJSON
 )
 
 // Open question: should UTF-8 become an output mode?  It does require more
@@ -38,6 +40,8 @@ const (
 
 var outputsEnabled struct {
 	UTF8       bool
+	NoColor    bool
+	Color      bool
 	HTML       bool
 	Markdown   bool
 	titleStyle titleStyle
@@ -55,6 +59,33 @@ type Table struct {
 	title      interface{}
 	titleCell  *Cell
 	outputMode outputMode
+	expanded   ExpandedMode
+	noColor    bool
+	// color is set when color is known to be wanted, by SetColor or from
+	// the capabilities of the output; placeholders are only dimmed then.
+	color bool
+
+	fixedBorders bool
+
+	columnOrder         []int
+	hiddenColumns       map[int]bool
+	columnPriority      map[int]int
+	dropColumns         bool
+	hiddenColumnsNote   bool
+	splitColumns        bool
+	keyColumns          []int
+	pageRows            int
+	minWidths           map[int]int
+	numberFormat        *NumberFormat
+	columnNumberFormats map[int]*NumberFormat
+	emptyPlaceholder    string
+	columnPlaceholders  map[int]string
+	sizeProvider        SizeProvider
+	terminalLines       int
+	tree                bool
+	treeDepth           int
+
+	htmlOptions HTMLOptions
 }
 
 // EnableUTF8 will unconditionally enable using UTF-8 box-drawing characters
@@ -79,35 +110,29 @@ func SetModeMarkdown(onoff bool) {
 	chooseDefaultOutput()
 }
 
-var utfRe = regexp.MustCompile(`utf\-8|utf8|UTF\-8|UTF8`)
-
 // EnableUTF8PerLocale will use current locale character map information to
 // determine if UTF-8 is expected and, if so, is equivalent to EnableUTF8.
 func EnableUTF8PerLocale() {
-	locale := getLocale()
-	if utfRe.MatchString(locale) {
+	if term.LocaleIsUTF8() {
 		EnableUTF8()
 	}
 }
 
-// getLocale returns the current locale name.
-func getLocale() string {
-	if runtime.GOOS == "windows" {
-		// TODO: detect windows locale
-		return "US-ASCII"
-	}
-	return unixLocale()
+// SetCapabilities sets the defaults for tables created after this call to
+// suit output with the given capabilities: UTF-8 box-drawing characters are
+// used if, and only if, caps.UTF8 is true, and color escape sequences in
+// cells are removed from terminal output if caps.Colors is term.ColorNone.
+func SetCapabilities(caps term.Capabilities) {
+	outputsEnabled.UTF8 = caps.UTF8
+	outputsEnabled.NoColor = caps.Colors == term.ColorNone
+	outputsEnabled.Color = caps.Colors != term.ColorNone
 }
 
-// unixLocale returns the locale by checking the $LC_ALL, $LC_CTYPE, and $LANG
-// environment variables. If none of those are set, it returns "US-ASCII".
-func unixLocale() string {
-	for _, env := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
-		if locale := os.Getenv(env); locale != "" {
-			return locale
-		}
-	}
-	return "US-ASCII"
+// EnablePerCapabilities sets the defaults for tables created after this
+// call from the capabilities of standard output, per term.GetCapabilities;
+// see SetCapabilities.
+func EnablePerCapabilities() {
+	SetCapabilities(term.GetCapabilities(os.Stdout))
 }
 
 // SetHTMLStyleTitle lets an HTML title output mode be chosen.
@@ -148,6 +173,8 @@ func CreateTable() *Table {
 		t.Style.htmlRules.title = outputsEnabled.titleStyle
 	}
 	t.outputMode = defaultOutputMode
+	t.noColor = outputsEnabled.NoColor
+	t.color = outputsEnabled.Color
 	return t
 }
 
@@ -212,17 +239,37 @@ func (t *Table) SetModeMarkdown() {
 	t.outputMode = outputMarkdown
 }
 
+// SetModeCSV switches this table to be in CSV mode, for consumption by other
+// programs; see RenderCSV.
+func (t *Table) SetModeCSV() {
+	t.outputMode = outputCSV
+}
+
 // SetModeTerminal switches this table to be in terminal mode.
 func (t *Table) SetModeTerminal() {
 	t.outputMode = outputTerminal
 }
 
+// SetColor controls whether color and emphasis escape sequences in cells are
+// kept in terminal output; the default depends upon the package function
+// SetCapabilities().  Other output modes are not affected.
+func (t *Table) SetColor(enabled bool) {
+	t.noColor = !enabled
+	t.color = enabled
+}
+
 // SetHTMLStyleTitle lets an HTML output mode be chosen; we should rework this
 // into a more generic and extensible API as we clean up termtables.
 func (t *Table) SetHTMLStyleTitle(want titleStyle) {
 	t.Style.htmlRules.title = want
 }
 
+// SetHTMLOptions controls the markup generated when this table is rendered as
+// HTML; see HTMLOptions for details.
+func (t *Table) SetHTMLOptions(opts HTMLOptions) {
+	t.htmlOptions = opts
+}
+
 // Render returns a string representation of a fully rendered table, drawn
 // out for display, with embedded newlines.  If this table is in HTML mode,
 // then this is equivalent to RenderHTML().
@@ -235,6 +282,10 @@ func (t *Table) Render() string {
 		return t.renderMarkdown()
 	case outputHTML:
 		return t.RenderHTML()
+	case outputCSV:
+		return t.RenderCSV()
+	case outputJSON:
+		return t.RenderJSON()
 	default:
 		panic("unknown output mode set")
 	}
@@ -243,6 +294,79 @@ func (t *Table) Render() string {
 // renderTerminal returns a string representation of a fully rendered table,
 // drawn out for display, with embedded newlines.
 func (t *Table) renderTerminal() string {
+	t = t.withDisplayValues()
+	if t.pageRows != 0 {
+		return t.renderPages()
+	}
+	if t.expanded == ExpandedOn {
+		return t.RenderVertical()
+	}
+
+	maxColumns := t.maxColumns()
+	columns := t.visibleColumns()
+	tt, style := t.projectColumns(columns).terminalLayout()
+
+	// Drop columns until the table fits, if so configured.
+	hidden := 0
+	if t.dropColumns {
+		if columns == nil {
+			columns = t.allColumns()
+		}
+		for style.Width > maxColumns && len(columns) > 1 {
+			columns = t.dropLowestPriority(columns)
+			hidden++
+			tt, style = t.projectColumns(columns).terminalLayout()
+		}
+	}
+
+	var b *bytes.Buffer
+	switch {
+	case t.splitColumns && style.Width > maxColumns:
+		b = bytes.NewBuffer(nil)
+		for i, page := range t.columnPages(columns, maxColumns) {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString(drawTerminal(page.terminalLayout()))
+		}
+	case t.expanded == ExpandedAuto && style.Width > maxColumns:
+		return t.projectColumns(columns).RenderVertical()
+	default:
+		b = bytes.NewBufferString(drawTerminal(tt, style))
+	}
+
+	if hidden > 0 && t.hiddenColumnsNote {
+		if hidden == 1 {
+			b.WriteString("+1 column hidden\n")
+		} else {
+			fmt.Fprintf(b, "+%d columns hidden\n", hidden)
+		}
+	}
+
+	return b.String()
+}
+
+// drawTerminal returns the drawing of a table as laid out by terminalLayout.
+func drawTerminal(tt *Table, style *renderStyle) string {
+	// Loop over the elements and render them.
+	b := bytes.NewBuffer(nil)
+	for _, e := range tt.elements {
+		b.WriteString(e.Render(style))
+		b.WriteString("\n")
+	}
+
+	// Add bottom line.
+	if !style.SkipBorder {
+		b.WriteString((&Separator{where: LINE_BOTTOM}).Render(style) + "\n")
+	}
+
+	return b.String()
+}
+
+// terminalLayout returns a copy of the table with the title, headers and
+// rule lines added as elements, ready for drawing in terminal mode, together
+// with the runtime style for drawing it.
+func (t *Table) terminalLayout() (*Table, *renderStyle) {
 	// Use a placeholder rather than adding titles/headers to the tables
 	// elements or else successive calls will compound them.
 	tt := t.clone()
@@ -261,7 +385,9 @@ func (t *Table) renderTerminal() string {
 	// If we have headers, include them.
 	if tt.headers != nil {
 		ne := make([]Element, 2)
-		ne[1] = CreateRow(tt.headers)
+		headers := CreateRow(tt.headers)
+		headers.header = true
+		ne[1] = headers
 		if tt.title != nil {
 			ne[0] = &Separator{where: LINE_SUBTOP}
 		} else {
@@ -285,19 +411,7 @@ func (t *Table) renderTerminal() string {
 	// generate the runtime style. Must include all cells being printed.
 	style := createRenderStyle(tt)
 
-	// Loop over the elements and render them.
-	b := bytes.NewBuffer(nil)
-	for _, e := range tt.elements {
-		b.WriteString(e.Render(style))
-		b.WriteString("\n")
-	}
-
-	// Add bottom line.
-	if !style.SkipBorder {
-		b.WriteString((&Separator{where: LINE_BOTTOM}).Render(style) + "\n")
-	}
-
-	return b.String()
+	return tt, style
 }
 
 // renderMarkdown returns a string representation of a table in Markdown
@@ -309,6 +423,7 @@ func (t *Table) renderMarkdown() string {
 	// tables as markdown is ignored in there.  Do need to do _something_
 	// with a '|' character shown as a member of a table.
 
+	t = t.withDisplayValues().withVisibleColumns()
 	t.Style.setAsciiBoxStyle()
 
 	firstLines := make([]Element, 0, 2)
@@ -323,7 +438,9 @@ func (t *Table) renderMarkdown() string {
 		}
 	}
 
-	firstLines = append(firstLines, CreateRow(t.headers))
+	headers := CreateRow(t.headers)
+	headers.header = true
+	firstLines = append(firstLines, headers)
 	// This is a dummy line, swapped out below.
 	firstLines = append(firstLines, firstLines[0])
 	t.elements = append(firstLines, t.elements...)
@@ -358,9 +475,12 @@ func (t *Table) renderMarkdown() string {
 }
 
 // clone returns a copy of the table with the underlying slices being copied;
-// the references to the Elements/cells are left as shallow copies.
+// the references to the Elements/cells, and the settings for columns, are
+// left as shallow copies.
 func (t *Table) clone() *Table {
-	tt := &Table{outputMode: t.outputMode, Style: t.Style, title: t.title}
+	tt := *t
+	tt.titleCell = nil
+	tt.headers, tt.elements = nil, nil
 	if t.headers != nil {
 		tt.headers = make([]interface{}, len(t.headers))
 		copy(tt.headers, t.headers)
@@ -369,5 +489,5 @@ func (t *Table) clone() *Table {
 		tt.elements = make([]Element, len(t.elements))
 		copy(tt.elements, t.elements)
 	}
-	return tt
+	return &tt
 }
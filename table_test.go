@@ -1,7 +1,11 @@
 // Copyright 2012-2013 Apcera Inc. All rights reserved.
 package termtables
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/scylladb/termtables/term"
+)
 
 func DisplayFailedOutput(actual, expected string) string {
 	return "Output didn't match expected\n\n" +
@@ -560,3 +564,32 @@ func BenchmarkTableRenderHTML(b *testing.B) {
 		table.Render()
 	}
 }
+
+func TestTableColor(t *testing.T) {
+	saved := outputsEnabled
+	defer func() { outputsEnabled = saved }()
+
+	SetCapabilities(term.Capabilities{Colors: term.ColorNone})
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Status")
+	table.AddRow("db1", "\033[32mup\033[0m")
+
+	expected := "" +
+		"+------+--------+\n" +
+		"| Name | Status |\n" +
+		"+------+--------+\n" +
+		"| db1  | up     |\n" +
+		"+------+--------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetColor(true)
+	expected = "" +
+		"+------+--------+\n" +
+		"| Name | Status |\n" +
+		"+------+--------+\n" +
+		"| db1  | \033[32mup\033[0m     |\n" +
+		"+------+--------+\n"
+	checkRendersTo(t, table, expected)
+}
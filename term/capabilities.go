@@ -0,0 +1,145 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package term
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ColorDepth is the number of colors which a terminal can show, as selected
+// by escape sequences.
+type ColorDepth int
+
+// These are the color depths which are told apart; each can show all the
+// colors of those before it.
+const (
+	// ColorNone means that escape sequences for color should not be used.
+	ColorNone ColorDepth = iota
+	// Color16 is the basic eight colors and their bright variants.
+	Color16
+	// Color256 is the xterm palette of 256 colors.
+	Color256
+	// ColorTrue is 24-bit RGB color.
+	ColorTrue
+)
+
+// String returns a short name for the color depth.
+func (d ColorDepth) String() string {
+	switch d {
+	case ColorNone:
+		return "none"
+	case Color16:
+		return "16"
+	case Color256:
+		return "256"
+	case ColorTrue:
+		return "truecolor"
+	}
+	return "ColorDepth(" + strconv.Itoa(int(d)) + ")"
+}
+
+// Capabilities describes what can be written to a file, as far as can be
+// told from the file itself and the process environment.
+type Capabilities struct {
+	// TTY is true if the file is a terminal.
+	TTY bool
+	// Colors is the color depth which should be used.
+	Colors ColorDepth
+	// UTF8 is true if the locale's character set is UTF-8.
+	UTF8 bool
+}
+
+// GetCapabilities returns the Capabilities of a file, usually os.Stdout.
+func GetCapabilities(file *os.File) Capabilities {
+	return capabilities(os.LookupEnv, IsTerminal(file))
+}
+
+// IsTerminal reports whether a file is a terminal, which is to say that a
+// window size can be found for it.
+func IsTerminal(file *os.File) bool {
+	if file == nil {
+		return false
+	}
+	_, err := GetTerminalWindowSize(file)
+	return err == nil
+}
+
+// capabilities works out the Capabilities from the environment, read with
+// lookup, for a file which is a terminal if tty is true.
+func capabilities(lookup func(string) (string, bool), tty bool) Capabilities {
+	return Capabilities{
+		TTY:    tty,
+		Colors: colorDepth(lookup, tty),
+		UTF8:   charsetIsUTF8(localeCharset(lookup)),
+	}
+}
+
+// colorDepth works out the color depth from the environment, following the
+// common conventions: $NO_COLOR, when not empty, disables color; $FORCE_COLOR
+// and $CLICOLOR_FORCE enable it even when not writing to a terminal, with
+// $FORCE_COLOR giving the depth as 1, 2 or 3; and $CLICOLOR=0 disables it
+// for a terminal.  Otherwise, $COLORTERM and $TERM give the depth.
+func colorDepth(lookup func(string) (string, bool), tty bool) ColorDepth {
+	if getenv(lookup, "NO_COLOR") != "" {
+		return ColorNone
+	}
+
+	forced := ColorNone
+	if force, ok := lookup("FORCE_COLOR"); ok {
+		switch strings.ToLower(force) {
+		case "0", "false", "no", "off":
+			return ColorNone
+		case "2":
+			forced = Color256
+		case "3":
+			forced = ColorTrue
+		default:
+			forced = Color16
+		}
+	} else if force := getenv(lookup, "CLICOLOR_FORCE"); force != "" && force != "0" {
+		forced = Color16
+	}
+
+	if forced == ColorNone {
+		if !tty || getenv(lookup, "CLICOLOR") == "0" {
+			return ColorNone
+		}
+	}
+
+	depth := termColorDepth(lookup)
+	if depth < forced {
+		depth = forced
+	}
+	return depth
+}
+
+// termColorDepth returns the color depth of the terminal named by $TERM,
+// raised to true color by $COLORTERM.
+func termColorDepth(lookup func(string) (string, bool)) ColorDepth {
+	switch strings.ToLower(getenv(lookup, "COLORTERM")) {
+	case "truecolor", "24bit":
+		return ColorTrue
+	}
+	name := strings.ToLower(getenv(lookup, "TERM"))
+	switch {
+	case name == "dumb":
+		return ColorNone
+	case strings.HasSuffix(name, "-direct"):
+		return ColorTrue
+	case strings.Contains(name, "256color"):
+		return Color256
+	case name == "" && getenv(lookup, "WT_SESSION") != "":
+		// Windows Terminal does not set $TERM.
+		return ColorTrue
+	}
+	return Color16
+}
+
+// getenv returns the value of an environment variable looked up with
+// lookup, or "" if it is not set.
+func getenv(lookup func(string) (string, bool), name string) string {
+	value, _ := lookup(name)
+	return value
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package term
+
+import (
+	"testing"
+)
+
+// environ returns a lookup function for a fixed environment.
+func environ(vars ...string) func(string) (string, bool) {
+	env := map[string]string{}
+	for i := 0; i+1 < len(vars); i += 2 {
+		env[vars[i]] = vars[i+1]
+	}
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+func TestColorDepth(t *testing.T) {
+	tests := []struct {
+		env  []string
+		tty  bool
+		want ColorDepth
+	}{
+		{[]string{"TERM", "xterm"}, true, Color16},
+		{[]string{"TERM", "xterm"}, false, ColorNone},
+		{[]string{"TERM", "xterm-256color"}, true, Color256},
+		{[]string{"TERM", "xterm-direct"}, true, ColorTrue},
+		{[]string{"TERM", "xterm-256color", "COLORTERM", "truecolor"}, true, ColorTrue},
+		{[]string{"TERM", "dumb"}, true, ColorNone},
+		{[]string{"TERM", "xterm", "NO_COLOR", "1"}, true, ColorNone},
+		{[]string{"TERM", "xterm", "NO_COLOR", ""}, true, Color16},
+		{[]string{"TERM", "xterm", "NO_COLOR", "1", "FORCE_COLOR", "1"}, false, ColorNone},
+		{[]string{"TERM", "xterm", "CLICOLOR", "0"}, true, ColorNone},
+		{[]string{"TERM", "xterm", "FORCE_COLOR", ""}, false, Color16},
+		{[]string{"TERM", "dumb", "FORCE_COLOR", "3"}, false, ColorTrue},
+		{[]string{"TERM", "xterm-256color", "FORCE_COLOR", "1"}, false, Color256},
+		{[]string{"TERM", "xterm", "FORCE_COLOR", "0"}, true, ColorNone},
+		{[]string{"TERM", "xterm", "CLICOLOR_FORCE", "1"}, false, Color16},
+		{[]string{"WT_SESSION", "x"}, true, ColorTrue},
+	}
+	for _, test := range tests {
+		if got := colorDepth(environ(test.env...), test.tty); got != test.want {
+			t.Errorf("colorDepth(%q, %v) = %v, want %v", test.env, test.tty, got, test.want)
+		}
+	}
+}
+
+func TestLocaleCharset(t *testing.T) {
+	tests := []struct {
+		env  []string
+		want string
+	}{
+		{[]string{"LANG", "en_US.UTF-8"}, "UTF-8"},
+		{[]string{"LANG", "en_US.utf8"}, "UTF-8"},
+		{[]string{"LANG", "C.UTF-8"}, "UTF-8"},
+		{[]string{"LANG", "de_DE.UTF-8@euro"}, "UTF-8"},
+		{[]string{"LANG", "de_DE.ISO-8859-15@euro"}, "ISO-8859-15"},
+		{[]string{"LANG", "en_GB.iso88591"}, "ISO-8859-1"},
+		{[]string{"LANG", "ru_RU.koi8r"}, "KOI8-R"},
+		{[]string{"LANG", "C"}, "US-ASCII"},
+		{[]string{"LANG", "POSIX"}, "US-ASCII"},
+		{[]string{"LANG", "en_US"}, "US-ASCII"},
+		{[]string{"LANG", "xx_XX.Unknown-Set"}, "Unknown-Set"},
+		{[]string{"LC_ALL", "C", "LANG", "en_US.UTF-8"}, "US-ASCII"},
+		{[]string{"LC_ALL", "", "LC_CTYPE", "C.UTF-8", "LANG", "C"}, "UTF-8"},
+	}
+	for _, test := range tests {
+		if got := localeCharset(environ(test.env...)); got != test.want {
+			t.Errorf("localeCharset(%q) = %q, want %q", test.env, got, test.want)
+		}
+	}
+
+	caps := capabilities(environ("LANG", "C.UTF-8", "TERM", "xterm-256color"), true)
+	if want := (Capabilities{TTY: true, Colors: Color256, UTF8: true}); caps != want {
+		t.Errorf("capabilities = %+v, want %+v", caps, want)
+	}
+}
+
+func TestLocale(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_NUMERIC", "de_DE.UTF-8@euro")
+	t.Setenv("LANG", "en_US.UTF-8")
+	if got := Locale("LC_NUMERIC"); got != "de_DE" {
+		t.Errorf("Locale(LC_NUMERIC) = %q, want de_DE", got)
+	}
+	if got := Locale("LC_TIME"); got != "en_US" {
+		t.Errorf("Locale(LC_TIME) = %q, want en_US", got)
+	}
+	t.Setenv("LANG", "")
+	if got := Locale("LC_TIME"); got != "C" {
+		t.Errorf("Locale(LC_TIME) = %q, want C", got)
+	}
+}
@@ -32,6 +32,7 @@ func GetSize() (*Size, error) {
 		// them
 		return nil, err
 	}
+	defer fh.Close()
 
 	size, err := GetTerminalWindowSize(fh)
 	if err != nil {
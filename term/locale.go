@@ -0,0 +1,148 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package term
+
+import (
+	"os"
+	"strings"
+)
+
+// charsetAliases maps character set names, lower-cased and with punctuation
+// removed, to their preferred MIME names.
+var charsetAliases = map[string]string{
+	"utf8":        "UTF-8",
+	"65001":       "UTF-8",
+	"cp65001":     "UTF-8",
+	"ascii":       "US-ASCII",
+	"usascii":     "US-ASCII",
+	"ansix341968": "US-ASCII",
+	"646":         "US-ASCII",
+	"iso88591":    "ISO-8859-1",
+	"latin1":      "ISO-8859-1",
+	"iso885915":   "ISO-8859-15",
+	"latin9":      "ISO-8859-15",
+	"iso88592":    "ISO-8859-2",
+	"latin2":      "ISO-8859-2",
+	"koi8r":       "KOI8-R",
+	"koi8u":       "KOI8-U",
+	"eucjp":       "EUC-JP",
+	"euckr":       "EUC-KR",
+	"gb2312":      "GB2312",
+	"gbk":         "GBK",
+	"gb18030":     "GB18030",
+	"big5":        "Big5",
+	"big5hkscs":   "Big5-HKSCS",
+	"sjis":        "Shift_JIS",
+	"shiftjis":    "Shift_JIS",
+	"cp1252":      "windows-1252",
+	"windows1252": "windows-1252",
+	"cp437":       "IBM437",
+	"ibm437":      "IBM437",
+	"tis620":      "TIS-620",
+	"armscii8":    "ARMSCII-8",
+	"georgianps":  "GEORGIAN-PS",
+	"iso885916":   "ISO-8859-16",
+	"iso88597":    "ISO-8859-7",
+	"iso88599":    "ISO-8859-9",
+	"iso88595":    "ISO-8859-5",
+	"iso88598":    "ISO-8859-8",
+	"iso88596":    "ISO-8859-6",
+	"iso885913":   "ISO-8859-13",
+	"iso885914":   "ISO-8859-14",
+	"iso88593":    "ISO-8859-3",
+	"iso88594":    "ISO-8859-4",
+	"iso885910":   "ISO-8859-10",
+	"iso885911":   "ISO-8859-11",
+	"cp1251":      "windows-1251",
+	"windows1251": "windows-1251",
+}
+
+// LocaleCharset returns the character set of the current locale, with its
+// preferred MIME name if it is known, such as "UTF-8" or "ISO-8859-1".  The
+// locale is taken from $LC_ALL, $LC_CTYPE or $LANG, the first which is set,
+// as for the C library; the "C" and "POSIX" locales, and a locale naming no
+// character set, are taken as "US-ASCII".  On Windows, if none is set, the
+// console's code page is used.
+func LocaleCharset() string {
+	return localeCharset(os.LookupEnv)
+}
+
+// LocaleIsUTF8 reports whether the character set of the current locale is
+// UTF-8, per LocaleCharset.
+func LocaleIsUTF8() bool {
+	return charsetIsUTF8(LocaleCharset())
+}
+
+// Locale returns the name of the current locale for a category, such as
+// "LC_NUMERIC", without any character set or modifier, such as "de_DE"; the
+// locale is taken from $LC_ALL, the variable named by the category, or $LANG,
+// the first which is set, as for the C library, or is "C" if none is.
+func Locale(category string) string {
+	locale := localeName(os.LookupEnv, category)
+	if locale == "" {
+		return "C"
+	}
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	return locale
+}
+
+// localeName returns the locale named in the environment, read with lookup,
+// for a category, or "" if none is.
+func localeName(lookup func(string) (string, bool), category string) string {
+	for _, name := range []string{"LC_ALL", category, "LANG"} {
+		if locale := getenv(lookup, name); locale != "" {
+			return locale
+		}
+	}
+	return ""
+}
+
+// localeCharset returns the character set of the locale named in the
+// environment, read with lookup.
+func localeCharset(lookup func(string) (string, bool)) string {
+	if locale := localeName(lookup, "LC_CTYPE"); locale != "" {
+		return parseLocaleCharset(locale)
+	}
+	if charset := consoleCharset(); charset != "" {
+		return charset
+	}
+	return "US-ASCII"
+}
+
+// parseLocaleCharset returns the character set of a locale name of the form
+// language[_territory][.charset][@modifier].
+func parseLocaleCharset(locale string) string {
+	if i := strings.IndexByte(locale, '@'); i >= 0 {
+		locale = locale[:i]
+	}
+	i := strings.IndexByte(locale, '.')
+	if i < 0 {
+		return "US-ASCII"
+	}
+	return canonicalCharset(locale[i+1:])
+}
+
+// canonicalCharset returns the preferred name of a character set, or the
+// name as given if it is not known.
+func canonicalCharset(charset string) string {
+	key := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		}
+		return -1
+	}, charset)
+	if name, ok := charsetAliases[key]; ok {
+		return name
+	}
+	return charset
+}
+
+// charsetIsUTF8 reports whether a name returned by localeCharset is UTF-8.
+func charsetIsUTF8(charset string) bool {
+	return charset == "UTF-8"
+}
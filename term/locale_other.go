@@ -0,0 +1,12 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+//go:build !windows
+// +build !windows
+
+package term
+
+// consoleCharset returns the character set of the console when there is no
+// locale in the environment; elsewhere than Windows, there is none.
+func consoleCharset() string {
+	return ""
+}
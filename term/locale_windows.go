@@ -0,0 +1,22 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+//go:build windows
+// +build windows
+
+package term
+
+import (
+	"strconv"
+)
+
+var procGetConsoleOutputCP = kernel32.NewProc("GetConsoleOutputCP")
+
+// consoleCharset returns the character set of the console's output code
+// page, or "" if there is no console.
+func consoleCharset() string {
+	cp, _, _ := procGetConsoleOutputCP.Call()
+	if cp == 0 {
+		return ""
+	}
+	return canonicalCharset("cp" + strconv.Itoa(int(cp)))
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package term
+
+import (
+	"context"
+)
+
+// WatchSize returns a channel on which the terminal window size, per GetSize,
+// is sent each time it changes, starting with the size at the time of the
+// call, until ctx is done, when the channel is closed.  If the size can't be
+// found, nothing is sent until it can.  The channel is buffered for one
+// value and only ever holds the latest, so a slow reader never sees a stale
+// size.
+func WatchSize(ctx context.Context) <-chan Size {
+	sizes := make(chan Size, 1)
+	changes := watchResize(ctx)
+	go func() {
+		defer close(sizes)
+		var last Size
+		for {
+			if size, err := GetSize(); err == nil && *size != last {
+				last = *size
+				// Replace any unread size with the latest one.
+				select {
+				case <-sizes:
+				default:
+				}
+				sizes <- last
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-changes:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+	return sizes
+}
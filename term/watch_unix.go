@@ -0,0 +1,37 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+//go:build !windows
+// +build !windows
+
+package term
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// watchResize returns a channel which receives whenever the terminal may
+// have been resized, as indicated by a SIGWINCH, until ctx is done.
+func watchResize(ctx context.Context) <-chan struct{} {
+	changes := make(chan struct{}, 1)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGWINCH)
+	go func() {
+		defer signal.Stop(signals)
+		defer close(changes)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				select {
+				case changes <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return changes
+}
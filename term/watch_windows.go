@@ -0,0 +1,38 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+//go:build windows
+// +build windows
+
+package term
+
+import (
+	"context"
+	"time"
+)
+
+// resizePollInterval is how often the console is checked for a new size, as
+// Windows has no signal for resizes.
+const resizePollInterval = 250 * time.Millisecond
+
+// watchResize returns a channel which receives periodically, so that the
+// size of the console is checked, until ctx is done.
+func watchResize(ctx context.Context) <-chan struct{} {
+	changes := make(chan struct{}, 1)
+	go func() {
+		defer close(changes)
+		ticker := time.NewTicker(resizePollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				select {
+				case changes <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return changes
+}
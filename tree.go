@@ -0,0 +1,157 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import "sort"
+
+// treeGuides are the strings drawn before the first cell of a row in a
+// tree: for a row with a later sibling, for the last of its siblings, and for
+// each level above, depending on whether that level has more rows to come.
+type treeGuides struct {
+	branch, last, through, space string
+}
+
+var (
+	utf8TreeGuides  = treeGuides{branch: "├─ ", last: "└─ ", through: "│  ", space: "   "}
+	asciiTreeGuides = treeGuides{branch: "|- ", last: "`- ", through: "|  ", space: "   "}
+)
+
+// AddChildRow adds the supplied items as cells in a row which is a child of
+// parent, a row of the table, after any children parent already has.  Rows
+// with children form a tree, drawn with guide lines before the first cell
+// of each child, in UTF-8 or ASCII to match the borders of the table, and
+// counted in the width of the column; in HTML output, rows are given an
+// aria-level attribute instead, and the table a treegrid role.  CSV and JSON
+// output hold the rows without guides.  If parent is not a row of the table,
+// the child is added at the end.
+func (t *Table) AddChildRow(parent *Row, items ...interface{}) *Row {
+	if parent.level == 0 {
+		parent.level = 1
+	}
+	row := CreateRow(items)
+	row.level = parent.level + 1
+	t.tree = true
+
+	at := len(t.elements)
+	for i, e := range t.elements {
+		if e != Element(parent) {
+			continue
+		}
+		at = i + 1
+		for at < len(t.elements) && rowLevel(t.elements[at]) > parent.level {
+			at++
+		}
+		break
+	}
+	t.elements = append(t.elements, nil)
+	copy(t.elements[at+1:], t.elements[at:])
+	t.elements[at] = row
+	return row
+}
+
+// SetTreeDepth collapses the trees of rows made with AddChildRow, so that
+// only the given number of levels are shown, counting the rows at the top as
+// the first level; zero, the default, shows all levels.  Collapsed rows are
+// left out of all output but CSV and JSON.
+func (t *Table) SetTreeDepth(levels int) {
+	t.treeDepth = levels
+}
+
+// rowLevel returns the level of a row in a tree, where rows which are not
+// in one are at the top level, or 0 for elements other than rows.
+func rowLevel(e Element) int {
+	row, ok := e.(*Row)
+	switch {
+	case !ok:
+		return 0
+	case row.level == 0:
+		return 1
+	}
+	return row.level
+}
+
+// treeElements returns the elements of a table with rows deeper than the
+// tree depth left out, and rows at the top level of trees given their level;
+// if guides are wanted, guides are put before the first cell of each child.
+// The rows must be copies, as made by displayValues, which are changed.
+func (t *Table) treeElements(elements []Element, guides bool) []Element {
+	shown := make([]Element, 0, len(elements))
+	for _, e := range elements {
+		if t.treeDepth == 0 || rowLevel(e) <= t.treeDepth {
+			shown = append(shown, e)
+		}
+	}
+
+	g := asciiTreeGuides
+	if t.Style.isUTF8Box() {
+		g = utf8TreeGuides
+	}
+	continues := map[int]bool{}
+	for i, e := range shown {
+		row, ok := e.(*Row)
+		if !ok {
+			continue
+		}
+		row.level = rowLevel(row)
+		if !guides || row.level < 2 || len(row.cells) == 0 {
+			continue
+		}
+		more := false
+		for _, next := range shown[i+1:] {
+			if level := rowLevel(next); level != 0 && level <= row.level {
+				more = level == row.level
+				break
+			}
+		}
+		continues[row.level] = more
+
+		prefix := ""
+		for level := 2; level < row.level; level++ {
+			if continues[level] {
+				prefix += g.through
+			} else {
+				prefix += g.space
+			}
+		}
+		if more {
+			prefix += g.branch
+		} else {
+			prefix += g.last
+		}
+		c := *row.cells[0]
+		c.formattedValue = prefix + c.formattedValue
+		row.cells[0] = &c
+	}
+	return shown
+}
+
+// sortTree sorts rows as a tree: the rows at the top level are sorted, each
+// with the rows below it, and in turn the children of each row.
+func sortTree(rows []Element, less func(a, b Element) bool) {
+	if len(rows) < 2 {
+		return
+	}
+	top := rowLevel(rows[0])
+	for _, e := range rows {
+		if l := rowLevel(e); l < top {
+			top = l
+		}
+	}
+	subtrees := [][]Element{}
+	for i, e := range rows {
+		if rowLevel(e) == top || i == 0 {
+			subtrees = append(subtrees, nil)
+		}
+		subtrees[len(subtrees)-1] = append(subtrees[len(subtrees)-1], e)
+	}
+	sort.SliceStable(subtrees, func(i, j int) bool {
+		return less(subtrees[i][0], subtrees[j][0])
+	})
+
+	sorted := make([]Element, 0, len(rows))
+	for _, subtree := range subtrees {
+		sortTree(subtree[1:], less)
+		sorted = append(sorted, subtree...)
+	}
+	copy(rows, sorted)
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func createTreeTestTable() *Table {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Size")
+	system := table.AddRow("system", 30)
+	peers := table.AddChildRow(system, "peers", 20)
+	table.AddChildRow(peers, "p1", 5)
+	table.AddChildRow(peers, "p2", 15)
+	table.AddChildRow(system, "local", 10)
+	app := table.AddRow("app", 5)
+	users := table.AddChildRow(app, "users", 5)
+	table.AddChildRow(users, "p9", 5)
+	table.AddChildRow(peers, "p0", 1)
+	return table
+}
+
+func TestTreeRows(t *testing.T) {
+	table := createTreeTestTable()
+	expected := "" +
+		"+----------+------+\n" +
+		"| Name     | Size |\n" +
+		"+----------+------+\n" +
+		"| system   | 30   |\n" +
+		"| |- peers | 20   |\n" +
+		"| |  |- p1 | 5    |\n" +
+		"| |  |- p2 | 15   |\n" +
+		"| |  `- p0 | 1    |\n" +
+		"| `- local | 10   |\n" +
+		"| app      | 5    |\n" +
+		"| `- users | 5    |\n" +
+		"|    `- p9 | 5    |\n" +
+		"+----------+------+\n"
+	checkRendersTo(t, table, expected)
+
+	if err := table.SortBy("Size", false); err != nil {
+		t.Fatal(err)
+	}
+	table.UTF8Box()
+	expected = "" +
+		"╭──────────┬──────╮\n" +
+		"│ Name     │ Size │\n" +
+		"├──────────┼──────┤\n" +
+		"│ app      │ 5    │\n" +
+		"│ └─ users │ 5    │\n" +
+		"│    └─ p9 │ 5    │\n" +
+		"│ system   │ 30   │\n" +
+		"│ ├─ local │ 10   │\n" +
+		"│ └─ peers │ 20   │\n" +
+		"│    ├─ p0 │ 1    │\n" +
+		"│    ├─ p1 │ 5    │\n" +
+		"│    └─ p2 │ 15   │\n" +
+		"╰──────────┴──────╯\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetTreeDepth(2)
+	expected = "" +
+		"╭──────────┬──────╮\n" +
+		"│ Name     │ Size │\n" +
+		"├──────────┼──────┤\n" +
+		"│ app      │ 5    │\n" +
+		"│ └─ users │ 5    │\n" +
+		"│ system   │ 30   │\n" +
+		"│ ├─ local │ 10   │\n" +
+		"│ └─ peers │ 20   │\n" +
+		"╰──────────┴──────╯\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestTreeRowsOtherModes(t *testing.T) {
+	table := createTreeTestTable()
+	table.SetTreeDepth(2)
+	table.SetModeHTML()
+	expected := "" +
+		"<table class=\"termtable\" role=\"treegrid\">\n" +
+		"<thead>\n" +
+		"<tr><th>Name</th><th>Size</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr aria-level=\"1\"><td>system</td><td>30</td></tr>\n" +
+		"<tr aria-level=\"2\"><td>peers</td><td>20</td></tr>\n" +
+		"<tr aria-level=\"2\"><td>local</td><td>10</td></tr>\n" +
+		"<tr aria-level=\"1\"><td>app</td><td>5</td></tr>\n" +
+		"<tr aria-level=\"2\"><td>users</td><td>5</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetModeCSV()
+	expected = "" +
+		"Name,Size\n" +
+		"system,30\n" +
+		"peers,20\n" +
+		"p1,5\n" +
+		"p2,15\n" +
+		"p0,1\n" +
+		"local,10\n" +
+		"app,5\n" +
+		"users,5\n" +
+		"p9,5\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestTreeRowsPagedAndExpanded(t *testing.T) {
+	table := createTreeTestTable()
+	table.SetTreeDepth(2)
+	table.SetPageRows(3)
+	expected := "" +
+		"+----------+------+\n" +
+		"| Name     | Size |\n" +
+		"+----------+------+\n" +
+		"| system   | 30   |\n" +
+		"| |- peers | 20   |\n" +
+		"| `- local | 10   |\n" +
+		"+----------+------+\n" +
+		"\n" +
+		"+----------+------+\n" +
+		"| Name     | Size |\n" +
+		"+----------+------+\n" +
+		"| app      | 5    |\n" +
+		"| `- users | 5    |\n" +
+		"+----------+------+\n"
+	checkRendersTo(t, table, expected)
+
+	table.SetPageRows(0)
+	table.SetExpanded(ExpandedOn)
+	expected = "" +
+		"+------+----------+\n" +
+		"| Name | system   |\n" +
+		"| Size | 30       |\n" +
+		"+------+----------+\n" +
+		"| Name | |- peers |\n" +
+		"| Size | 20       |\n" +
+		"+------+----------+\n" +
+		"| Name | `- local |\n" +
+		"| Size | 10       |\n" +
+		"+------+----------+\n" +
+		"| Name | app      |\n" +
+		"| Size | 5        |\n" +
+		"+------+----------+\n" +
+		"| Name | `- users |\n" +
+		"| Size | 5        |\n" +
+		"+------+----------+\n"
+	checkRendersTo(t, table, expected)
+}
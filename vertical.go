@@ -0,0 +1,76 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import "strconv"
+
+// ExpandedMode controls whether a table in terminal mode is drawn with one
+// line per row, or expanded with one block of lines per row, like the
+// expanded display of psql.
+type ExpandedMode int
+
+const (
+	// ExpandedOff draws tables normally; this is the default.
+	ExpandedOff ExpandedMode = iota
+	// ExpandedOn always draws tables expanded, as RenderVertical.
+	ExpandedOn
+	// ExpandedAuto draws tables expanded only when they would otherwise be
+	// wider than the available columns (see SetSizeProvider).
+	ExpandedAuto
+)
+
+// SetExpanded controls whether this table is drawn expanded in terminal mode;
+// see ExpandedMode.
+func (t *Table) SetExpanded(mode ExpandedMode) {
+	t.expanded = mode
+}
+
+// RenderVertical returns a string representation of the table drawn with a
+// block of lines for each row, each line holding a header and the value of
+// that column for the row, with a separator line between rows.  This suits
+// rows with many columns, which would be too wide to draw normally.  The
+// title, if any, is drawn across the top; columns without a header are named
+// by their number, counting from 1.  Separators in the table are not drawn,
+// as every row is already separated; the rows starting groups made by
+// GroupBy are drawn across the table.
+func (t *Table) RenderVertical() string {
+	t = t.withDisplayValues().withVisibleColumns()
+	headers := CreateRow(t.headers)
+
+	// The settings for drawing carry over, but those for the columns and
+	// rows of the table do not apply to the two columns of records.
+	vt := t.clone()
+	vt.headers, vt.elements = nil, []Element{}
+	vt.outputMode, vt.expanded = outputTerminal, ExpandedOff
+	vt.columnOrder, vt.hiddenColumns, vt.columnPriority = nil, nil, nil
+	vt.dropColumns, vt.splitColumns, vt.keyColumns = false, false, nil
+	vt.pageRows, vt.minWidths = 0, nil
+	vt.tree, vt.treeDepth = false, 0
+	records := 0
+	for _, e := range t.elements {
+		row, ok := e.(*Row)
+		if !ok {
+			continue
+		}
+		if records > 0 {
+			vt.AddSeparator()
+		}
+		records++
+		if row.groupHeader {
+			c := *row.cells[0]
+			vt.AddRow(&c).groupHeader = true
+			continue
+		}
+		columns := row.cellColumns()
+		for i, c := range row.cells {
+			column := columns[i]
+			var name interface{} = strconv.Itoa(column + 1)
+			if column < len(headers.cells) {
+				name = headers.cells[column].formattedValue
+			}
+			value := &Cell{formattedValue: c.formattedValue, alignment: c.alignment, colSpan: 1}
+			vt.AddRow(name, value)
+		}
+	}
+	return vt.renderTerminal()
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package termtables
+
+import (
+	"testing"
+)
+
+func TestRenderVertical(t *testing.T) {
+	expected := "" +
+		"+-------------+\n" +
+		"|    Nodes    |\n" +
+		"+------+------+\n" +
+		"| Name | hey  |\n" +
+		"| Load |  1.5 |\n" +
+		"+------+------+\n" +
+		"| Name | ken  |\n" +
+		"| Load | 12.0 |\n" +
+		"| 3    | x    |\n" +
+		"+------+------+\n"
+
+	table := CreateTable()
+	table.AddTitle("Nodes")
+	table.AddHeaders("Name", "Load")
+	table.AddRow("hey", CreateCell("1.5", &CellStyle{Alignment: AlignRight}))
+	table.AddSeparator()
+	table.AddRow("ken", "12.0", "x")
+
+	output := table.RenderVertical()
+	if output != expected {
+		t.Fatal(DisplayFailedOutput(output, expected))
+	}
+
+	table.SetExpanded(ExpandedOn)
+	checkRendersTo(t, table, expected)
+}
+
+func TestRenderExpandedAuto(t *testing.T) {
+	saved := MaxColumns
+	defer func() { MaxColumns = saved }()
+
+	table := CreateTable()
+	table.AddHeaders("Name", "Value")
+	table.AddRow("hey", "you")
+	table.SetExpanded(ExpandedAuto)
+
+	MaxColumns = 80
+	expected := "" +
+		"+------+-------+\n" +
+		"| Name | Value |\n" +
+		"+------+-------+\n" +
+		"| hey  | you   |\n" +
+		"+------+-------+\n"
+	checkRendersTo(t, table, expected)
+
+	MaxColumns = 10
+	expected = "" +
+		"+-------+-----+\n" +
+		"| Name  | hey |\n" +
+		"| Value | you |\n" +
+		"+-------+-----+\n"
+	checkRendersTo(t, table, expected)
+}
+
+func TestRenderVerticalSettings(t *testing.T) {
+	table := CreateTable()
+	table.Style = &TableStyle{}
+	*table.Style = *DefaultStyle
+	table.AddHeaders("Name", "Rack", "State")
+	table.AddRow("n1", "r1", "\033[32mUP\033[0m")
+	table.AddRow(CreateCell("n2 in r2", &CellStyle{ColSpan: 2}), "DOWN")
+	table.SetExpanded(ExpandedOn)
+	table.SetColor(false)
+
+	expected := "" +
+		"+-------+----------+\n" +
+		"| Name  | n1       |\n" +
+		"| Rack  | r1       |\n" +
+		"| State | UP       |\n" +
+		"+-------+----------+\n" +
+		"| Name  | n2 in r2 |\n" +
+		"| State | DOWN     |\n" +
+		"+-------+----------+\n"
+	checkRendersTo(t, table, expected)
+}
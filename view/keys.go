@@ -0,0 +1,89 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package view
+
+import (
+	"unicode/utf8"
+)
+
+// A Key is a key pressed by the user: either a character, given as its rune,
+// or one of the special keys below, which are all negative.
+type Key rune
+
+// Special keys, decoded from the escape sequences sent by terminals.
+const (
+	KeyUp Key = -1 - iota
+	KeyDown
+	KeyRight
+	KeyLeft
+	KeyPageUp
+	KeyPageDown
+	KeyHome
+	KeyEnd
+)
+
+// Control characters which the viewer acts upon.
+const (
+	KeyCtrlC     Key = 0x03
+	KeyBackspace Key = 0x7f
+	KeyEnter     Key = '\r'
+	KeyEscape    Key = 0x1b
+)
+
+// escapeKeys maps the escape sequences sent by common terminals for special
+// keys, less the leading ESC, to the keys.
+var escapeKeys = map[string]Key{
+	"[A": KeyUp, "[B": KeyDown, "[C": KeyRight, "[D": KeyLeft,
+	"OA": KeyUp, "OB": KeyDown, "OC": KeyRight, "OD": KeyLeft,
+	"[5~": KeyPageUp, "[6~": KeyPageDown,
+	"[H": KeyHome, "[F": KeyEnd, "OH": KeyHome, "OF": KeyEnd,
+	"[1~": KeyHome, "[4~": KeyEnd, "[7~": KeyHome, "[8~": KeyEnd,
+}
+
+// DecodeKeys returns the keys represented by input read from a terminal in
+// raw mode.  An escape sequence which is not recognized is dropped; an ESC
+// which does not start a sequence is KeyEscape.
+func DecodeKeys(input []byte) []Key {
+	keys := []Key{}
+	for i := 0; i < len(input); {
+		switch c := input[i]; {
+		case c == 0x1b:
+			key, n := decodeEscape(input[i+1:])
+			if key != 0 {
+				keys = append(keys, key)
+			}
+			i += 1 + n
+		case c == '\n':
+			keys = append(keys, KeyEnter)
+			i++
+		case c == 0x08:
+			keys = append(keys, KeyBackspace)
+			i++
+		default:
+			r, size := utf8.DecodeRune(input[i:])
+			keys = append(keys, Key(r))
+			i += size
+		}
+	}
+	return keys
+}
+
+// decodeEscape decodes the remainder of an escape sequence, returning the key
+// (0 if unrecognized) and the number of bytes consumed.
+func decodeEscape(rest []byte) (Key, int) {
+	if len(rest) == 0 || (rest[0] != '[' && rest[0] != 'O') {
+		return KeyEscape, 0
+	}
+	// A CSI sequence ends at the first byte in the range @ to ~; SS3
+	// sequences are always one byte after the O.
+	end := 1
+	if rest[0] == '[' {
+		for end < len(rest) && (rest[end] < 0x40 || rest[end] > 0x7e) {
+			end++
+		}
+	}
+	if end >= len(rest) {
+		return 0, len(rest)
+	}
+	return escapeKeys[string(rest[:end+1])], end + 1
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package view
+
+// A Screen is somewhere a Viewer can be shown.
+type Screen interface {
+	// Size returns the size of the screen, in character cells.
+	Size() (columns, lines int, err error)
+
+	// Show replaces the content of the screen with the lines of a frame.
+	Show(frame []string) error
+}
+
+// A MemoryScreen is a Screen held in memory, for testing programs which use
+// a Viewer without needing a terminal.
+type MemoryScreen struct {
+	Columns, Lines int
+
+	// Frame is the frame last shown, and Frames the number shown.
+	Frame  []string
+	Frames int
+}
+
+// Size returns the size set in the MemoryScreen.
+func (s *MemoryScreen) Size() (int, int, error) {
+	return s.Columns, s.Lines, nil
+}
+
+// Show records the frame in the MemoryScreen.
+func (s *MemoryScreen) Show(frame []string) error {
+	s.Frame = append([]string(nil), frame...)
+	s.Frames++
+	return nil
+}
+
+// Loop shows the viewer on the screen, acting upon keys and fetching the size
+// of the screen again on each receive from resize, until the user quits or
+// keys is closed.  A nil resize channel is never ready.
+func (v *Viewer) Loop(screen Screen, keys <-chan Key, resize <-chan struct{}) error {
+	if err := v.resize(screen); err != nil {
+		return err
+	}
+	for !v.done {
+		if err := screen.Show(v.Frame()); err != nil {
+			return err
+		}
+		select {
+		case k, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			v.HandleKey(k)
+		case <-resize:
+			v.Relayout()
+			if err := v.resize(screen); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resize sets the size of the viewer to that of the screen.
+func (v *Viewer) resize(screen Screen) error {
+	columns, lines, err := screen.Size()
+	if err != nil {
+		return err
+	}
+	v.SetSize(columns, lines)
+	return nil
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package view
+
+import "syscall"
+
+const (
+	ioctlGetTermios = syscall.TCGETS
+	ioctlSetTermios = syscall.TCSETS
+)
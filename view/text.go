@@ -0,0 +1,89 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package view
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// sgrSequence matches the color escape sequences which may be in tables; see
+// the matching filter in termtables.
+var sgrSequence = regexp.MustCompile(`\033\[(?:\d+(?:;\d+)*)?m`)
+
+// plain returns s without color escape sequences.
+func plain(s string) string {
+	return sgrSequence.ReplaceAllString(s, "")
+}
+
+// sliceColumns returns the part of s between the display columns from and to.
+// Color escape sequences before the end of the part are kept, so that colors
+// carry over, and reset at the end.  Wide characters cut by either end are
+// replaced by spaces.
+func sliceColumns(s string, from, to int) string {
+	if to <= from {
+		return ""
+	}
+	escapes := sgrSequence.FindAllStringIndex(s, -1)
+	b := bytes.NewBuffer(nil)
+	colored := false
+	column := 0
+	for i := 0; i < len(s) && column < to; {
+		if len(escapes) > 0 && escapes[0][0] == i {
+			b.WriteString(s[i:escapes[0][1]])
+			colored = true
+			i = escapes[0][1]
+			escapes = escapes[1:]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		w := runewidth.RuneWidth(r)
+		switch {
+		case column >= from && column+w <= to:
+			b.WriteString(s[i : i+size])
+		case column+w > from && column < to:
+			// partly visible wide character
+			start, end := column, column+w
+			if start < from {
+				start = from
+			}
+			if end > to {
+				end = to
+			}
+			b.WriteString(strings.Repeat(" ", end-start))
+		}
+		column += w
+		i += size
+	}
+	if colored {
+		b.WriteString("\033[0m")
+	}
+	return b.String()
+}
+
+// highlight shows the matches of query in s, ignoring case, in reverse
+// video.  Lines which already have color escape sequences are left alone.
+func highlight(s, query string) string {
+	if query == "" || strings.Contains(s, "\033") {
+		return s
+	}
+	lower, q := strings.ToLower(s), strings.ToLower(query)
+	if len(lower) != len(s) {
+		return s
+	}
+	b := bytes.NewBuffer(nil)
+	for {
+		i := strings.Index(lower, q)
+		if i < 0 {
+			break
+		}
+		b.WriteString(s[:i] + "\033[7m" + s[i:i+len(q)] + "\033[27m")
+		s, lower = s[i+len(q):], lower[i+len(q):]
+	}
+	b.WriteString(s)
+	return b.String()
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+//go:build !linux && !darwin && !dragonfly && !freebsd && !netbsd && !openbsd
+// +build !linux,!darwin,!dragonfly,!freebsd,!netbsd,!openbsd
+
+package view
+
+import (
+	"errors"
+
+	"github.com/scylladb/termtables"
+)
+
+// ErrNotSupported is returned by Show on platforms where it can't yet put the
+// terminal into raw mode.
+var ErrNotSupported = errors.New("view: terminal viewer not supported on this platform")
+
+// Show would display the table in a full-screen viewer; on this platform, it
+// returns ErrNotSupported.
+func Show(t *termtables.Table) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+//go:build linux || darwin || dragonfly || freebsd || netbsd || openbsd
+// +build linux darwin dragonfly freebsd netbsd openbsd
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"github.com/scylladb/termtables"
+	"github.com/scylladb/termtables/term"
+)
+
+// Show displays the table in a full-screen viewer on the controlling terminal
+// (/dev/tty), so it works even when standard input and output are not the
+// terminal, until the user quits.  The terminal is put into raw mode, and the
+// viewer is redrawn when the terminal is resized.
+func Show(t *termtables.Table) error {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer tty.Close()
+
+	restore, err := makeRaw(tty)
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	// Use the alternate screen, and hide the cursor, while viewing.
+	tty.WriteString("\033[?1049h\033[?25l")
+	defer tty.WriteString("\033[?25h\033[?1049l")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resize := make(chan struct{}, 1)
+	go func() {
+		for range term.WatchSize(ctx) {
+			select {
+			case resize <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	keys := make(chan Key)
+	go readKeys(ctx, tty, keys)
+
+	return NewViewer(t).Loop(&ttyScreen{tty}, keys, resize)
+}
+
+// readKeys sends the keys read from a terminal until reading fails, such as
+// when the terminal is closed, or the context is done.
+func readKeys(ctx context.Context, tty *os.File, keys chan<- Key) {
+	defer close(keys)
+	buf := make([]byte, 64)
+	for {
+		n, err := tty.Read(buf)
+		for _, k := range DecodeKeys(buf[:n]) {
+			select {
+			case keys <- k:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// ttyScreen is a Screen on a terminal.
+type ttyScreen struct {
+	file *os.File
+}
+
+func (s *ttyScreen) Size() (int, int, error) {
+	size, err := term.GetTerminalWindowSize(s.file)
+	if err != nil {
+		return 0, 0, err
+	}
+	return size.Columns, size.Lines, nil
+}
+
+func (s *ttyScreen) Show(frame []string) error {
+	b := bytes.NewBufferString("\033[H")
+	for i, line := range frame {
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+		b.WriteString("\033[K")
+	}
+	b.WriteString("\033[J")
+	_, err := s.file.Write(b.Bytes())
+	return err
+}
+
+// makeRaw puts a terminal into raw mode, as cfmakeraw(3) does, returning a
+// function to restore its previous mode.
+func makeRaw(tty *os.File) (func(), error) {
+	var old syscall.Termios
+	if err := termiosIoctl(tty, ioctlGetTermios, &old); err != nil {
+		return nil, err
+	}
+	raw := old
+	raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP |
+		syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	raw.Oflag &^= syscall.OPOST
+	raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	if err := termiosIoctl(tty, ioctlSetTermios, &raw); err != nil {
+		return nil, err
+	}
+	return func() { termiosIoctl(tty, ioctlSetTermios, &old) }, nil
+}
+
+// termiosIoctl gets or sets the termios settings of a terminal.
+func termiosIoctl(tty *os.File, request uintptr, termios *syscall.Termios) error {
+	if _, _, err := syscall.Syscall(syscall.SYS_IOCTL, tty.Fd(), request, uintptr(unsafe.Pointer(termios))); err != 0 {
+		return err
+	}
+	return nil
+}
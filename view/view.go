@@ -0,0 +1,282 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+// Package view provides a full-screen viewer for tables which are too large
+// to take in at once, with the header rows and first column frozen in place
+// while scrolling through the rest, and searching.
+//
+// Show runs the viewer on the controlling terminal.  The Viewer type holds
+// the state of the viewer and draws frames, independently of any terminal,
+// so that it can be driven by other programs and tested with a MemoryScreen.
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	runewidth "github.com/mattn/go-runewidth"
+	"github.com/scylladb/termtables"
+)
+
+// A Viewer holds the state of a view of one table: the size of the screen,
+// the scroll position and any search.
+type Viewer struct {
+	table  *termtables.Table
+	layout *termtables.Layout
+
+	columns, lines int
+	top, left      int
+
+	searching bool
+	input     string
+	query     string
+	match     int
+	message   string
+	done      bool
+}
+
+// NewViewer returns a Viewer for a table, with an 80 by 24 screen until told
+// otherwise with SetSize.
+func NewViewer(t *termtables.Table) *Viewer {
+	v := &Viewer{table: t, columns: 80, lines: 24, match: -1}
+	v.Relayout()
+	return v
+}
+
+// Relayout draws the table again, for when it has been changed.
+func (v *Viewer) Relayout() {
+	v.layout = v.table.Layout()
+	v.clamp()
+}
+
+// SetSize sets the size of the screen, in character cells.
+func (v *Viewer) SetSize(columns, lines int) {
+	if columns < 1 {
+		columns = 1
+	}
+	if lines < 2 {
+		lines = 2
+	}
+	v.columns, v.lines = columns, lines
+	v.clamp()
+}
+
+// Done reports whether the user has asked to quit.
+func (v *Viewer) Done() bool {
+	return v.done
+}
+
+// headerLines returns the number of frozen lines at the top; if the screen
+// can't hold them and at least one more line, nothing is frozen.
+func (v *Viewer) headerLines() int {
+	if v.layout.HeaderLines+2 > v.lines {
+		return 0
+	}
+	return v.layout.HeaderLines
+}
+
+// bodyHeight returns the number of lines available for scrolled lines, below
+// the frozen lines and above the status line.
+func (v *Viewer) bodyHeight() int {
+	return v.lines - v.headerLines() - 1
+}
+
+// frozenWidth returns the width of the frozen first column, including its
+// borders, or 0 if it's too wide to freeze or there's only one column.
+func (v *Viewer) frozenWidth() int {
+	edges := v.layout.ColumnEdges
+	if len(edges) < 3 || edges[1]+1 >= v.columns {
+		return 0
+	}
+	return edges[1] + 1
+}
+
+// scrollStops returns the horizontal offsets at which columns start, past
+// the frozen column.
+func (v *Viewer) scrollStops() []int {
+	frozen := v.frozenWidth()
+	stops := []int{0}
+	for _, edge := range v.layout.ColumnEdges {
+		if edge+1 > frozen {
+			stops = append(stops, edge+1-frozen)
+		}
+	}
+	return stops
+}
+
+// clamp keeps the scroll position within the table.
+func (v *Viewer) clamp() {
+	body := len(v.layout.Lines) - v.headerLines()
+	if max := body - v.bodyHeight(); v.top > max {
+		v.top = max
+	}
+	if v.top < 0 {
+		v.top = 0
+	}
+	// Scroll no further right than the first column start which shows the
+	// right-hand edge of the table.
+	width := v.layout.ColumnEdges[len(v.layout.ColumnEdges)-1] + 1
+	max := 0
+	for _, stop := range v.scrollStops() {
+		max = stop
+		if stop >= width-v.columns {
+			break
+		}
+	}
+	if v.left > max {
+		v.left = max
+	}
+	if v.left < 0 {
+		v.left = 0
+	}
+}
+
+// HandleKey acts upon one key pressed by the user.
+func (v *Viewer) HandleKey(k Key) {
+	v.message = ""
+	if v.searching {
+		v.handleSearchKey(k)
+		return
+	}
+	switch k {
+	case 'q', 'Q', KeyEscape, KeyCtrlC:
+		v.done = true
+	case KeyUp, 'k':
+		v.top--
+	case KeyDown, 'j', KeyEnter:
+		v.top++
+	case KeyPageUp, 'b':
+		v.top -= v.bodyHeight()
+	case KeyPageDown, 'f', ' ':
+		v.top += v.bodyHeight()
+	case KeyHome, 'g':
+		v.top, v.left = 0, 0
+	case KeyEnd, 'G':
+		v.top = len(v.layout.Lines)
+	case KeyLeft, 'h':
+		stops := v.scrollStops()
+		for i := len(stops) - 1; i >= 0; i-- {
+			if stops[i] < v.left {
+				v.left = stops[i]
+				break
+			}
+		}
+	case KeyRight, 'l':
+		for _, stop := range v.scrollStops() {
+			if stop > v.left {
+				v.left = stop
+				break
+			}
+		}
+	case '/':
+		v.searching, v.input = true, ""
+	case 'n':
+		v.search(1)
+	case 'N':
+		v.search(-1)
+	}
+	v.clamp()
+}
+
+// handleSearchKey acts upon a key pressed while typing a search.
+func (v *Viewer) handleSearchKey(k Key) {
+	switch {
+	case k == KeyEnter:
+		v.searching = false
+		if v.input != "" {
+			v.query = v.input
+			v.match = v.headerLines() + v.top - 1
+			v.search(1)
+		}
+	case k == KeyEscape || k == KeyCtrlC:
+		v.searching = false
+	case k == KeyBackspace:
+		if r := []rune(v.input); len(r) > 0 {
+			v.input = string(r[:len(r)-1])
+		}
+	case k >= ' ':
+		v.input += string(rune(k))
+	}
+}
+
+// search finds the next line, in the given direction from the last match,
+// containing the query, ignoring case, and scrolls to show it.
+func (v *Viewer) search(direction int) {
+	if v.query == "" {
+		return
+	}
+	query := strings.ToLower(v.query)
+	lines := v.layout.Lines
+	header := v.headerLines()
+	for i := v.match + direction; i >= header && i < len(lines); i += direction {
+		column := strings.Index(strings.ToLower(plain(lines[i])), query)
+		if column < 0 {
+			continue
+		}
+		v.match = i
+		if body := i - header; body < v.top || body >= v.top+v.bodyHeight() {
+			v.top = body
+		}
+		column = runewidth.StringWidth(plain(lines[i])[:column])
+		frozen := v.frozenWidth()
+		if column >= frozen {
+			offset := column - frozen
+			if offset < v.left || offset+runewidth.StringWidth(v.query) > v.left+v.columns-frozen {
+				v.left = 0
+				for _, stop := range v.scrollStops() {
+					if stop <= offset {
+						v.left = stop
+					}
+				}
+			}
+		}
+		v.clamp()
+		return
+	}
+	v.message = "Pattern not found: " + v.query
+}
+
+// Frame returns the lines to be shown on the screen, exactly filling it.
+func (v *Viewer) Frame() []string {
+	frame := make([]string, 0, v.lines)
+	header := v.headerLines()
+	for _, line := range v.layout.Lines[:header] {
+		frame = append(frame, v.visible(line))
+	}
+	body := v.layout.Lines[header:]
+	for i := v.top; i < len(body) && i < v.top+v.bodyHeight(); i++ {
+		frame = append(frame, v.visible(body[i]))
+	}
+	for len(frame) < v.lines-1 {
+		frame = append(frame, "~")
+	}
+	return append(frame, v.status())
+}
+
+// visible returns the part of a line which is shown on the screen: the
+// frozen column, and the scrolled part of the rest, with search matches
+// highlighted.
+func (v *Viewer) visible(line string) string {
+	frozen := v.frozenWidth()
+	shown := sliceColumns(line, 0, frozen) +
+		sliceColumns(line, frozen+v.left, frozen+v.left+v.columns-frozen)
+	return highlight(shown, v.query)
+}
+
+// status returns the status line shown at the bottom of the screen.
+func (v *Viewer) status() string {
+	var s string
+	switch {
+	case v.searching:
+		s = "/" + v.input
+	case v.message != "":
+		s = v.message
+	default:
+		body := len(v.layout.Lines) - v.headerLines()
+		last := v.top + v.bodyHeight()
+		if last > body {
+			last = body
+		}
+		s = fmt.Sprintf("lines %d-%d/%d (q: quit, /: search)", v.top+1, last, body)
+	}
+	return sliceColumns(s, 0, v.columns)
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 ScyllaDB. All rights reserved.
+
+package view
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/scylladb/termtables"
+)
+
+func createTestTable() *termtables.Table {
+	table := termtables.CreateTable()
+	table.Style = &termtables.TableStyle{}
+	*table.Style = *termtables.DefaultStyle
+	table.AddHeaders("Name", "Alpha", "Beta", "Gamma")
+	for i := 1; i <= 20; i++ {
+		table.AddRow(fmt.Sprintf("row%02d", i), "aaaaaaaa", "bbbbbbbb", fmt.Sprintf("g%d", i))
+	}
+	return table
+}
+
+func checkFrame(t *testing.T, v *Viewer, expected []string) {
+	frame := v.Frame()
+	if !reflect.DeepEqual(frame, expected) {
+		t.Fatalf("Unexpected frame\n\nActual:\n\n%s\n\nExpected:\n\n%s",
+			strings.Join(frame, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestDecodeKeys(t *testing.T) {
+	keys := DecodeKeys([]byte("q\x1b[A\x1b[6~\x1bOH\x1b[99Zé\r\x7f\x1b"))
+	expected := []Key{'q', KeyUp, KeyPageDown, KeyHome, 'é', KeyEnter, KeyBackspace, KeyEscape}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("Unexpected keys %v, expected %v", keys, expected)
+	}
+}
+
+func TestViewerScrolling(t *testing.T) {
+	v := NewViewer(createTestTable())
+	v.SetSize(30, 7)
+
+	checkFrame(t, v, []string{
+		"+-------+----------+----------",
+		"| Name  | Alpha    | Beta     ",
+		"+-------+----------+----------",
+		"| row01 | aaaaaaaa | bbbbbbbb ",
+		"| row02 | aaaaaaaa | bbbbbbbb ",
+		"| row03 | aaaaaaaa | bbbbbbbb ",
+		"lines 1-3/21 (q: quit, /: sear",
+	})
+
+	v.HandleKey(KeyPageDown)
+	v.HandleKey(KeyRight)
+	v.HandleKey(KeyDown)
+	checkFrame(t, v, []string{
+		"+-------+----------+-------+",
+		"| Name  | Beta     | Gamma |",
+		"+-------+----------+-------+",
+		"| row05 | bbbbbbbb | g5    |",
+		"| row06 | bbbbbbbb | g6    |",
+		"| row07 | bbbbbbbb | g7    |",
+		"lines 5-7/21 (q: quit, /: sear",
+	})
+
+	v.HandleKey(KeyEnd)
+	v.HandleKey(KeyLeft)
+	checkFrame(t, v, []string{
+		"+-------+----------+----------",
+		"| Name  | Alpha    | Beta     ",
+		"+-------+----------+----------",
+		"| row19 | aaaaaaaa | bbbbbbbb ",
+		"| row20 | aaaaaaaa | bbbbbbbb ",
+		"+-------+----------+----------",
+		"lines 19-21/21 (q: quit, /: se",
+	})
+
+	v.HandleKey('q')
+	if !v.Done() {
+		t.Fatal("Viewer did not quit")
+	}
+}
+
+func TestViewerSearch(t *testing.T) {
+	v := NewViewer(createTestTable())
+	v.SetSize(30, 6)
+	for _, k := range "/ROW12\r" {
+		v.HandleKey(Key(k))
+	}
+	checkFrame(t, v, []string{
+		"+-------+----------+----------",
+		"| Name  | Alpha    | Beta     ",
+		"+-------+----------+----------",
+		"| \033[7mrow12\033[27m | aaaaaaaa | bbbbbbbb ",
+		"| row13 | aaaaaaaa | bbbbbbbb ",
+		"lines 12-13/21 (q: quit, /: se",
+	})
+
+	for _, k := range "/nothing\r" {
+		v.HandleKey(Key(k))
+	}
+	if frame := v.Frame(); frame[len(frame)-1] != "Pattern not found: nothing" {
+		t.Fatalf("Unexpected status line %q", frame[len(frame)-1])
+	}
+}
+
+func TestViewerSearchWide(t *testing.T) {
+	table := termtables.CreateTable()
+	table.Style = &termtables.TableStyle{}
+	*table.Style = *termtables.DefaultStyle
+	table.AddHeaders("Name", "Alpha", "Wide")
+	table.AddRow("r1", "aaaaaaaa", "bb")
+	table.AddRow("r2", "aaaaaaaa", "b日本")
+	v := NewViewer(table)
+	v.SetSize(25, 6)
+	// The match fits on the screen, so there should be no scrolling.
+	for _, k := range "/日本\r" {
+		v.HandleKey(Key(k))
+	}
+	checkFrame(t, v, []string{
+		"+------+----------+------",
+		"| Name | Alpha    | Wide ",
+		"+------+----------+------",
+		"| r1   | aaaaaaaa | bb   ",
+		"| r2   | aaaaaaaa | b\033[7m日本\033[27m",
+		"lines 1-2/3 (q: quit, /: ",
+	})
+}
+
+func TestViewerLoop(t *testing.T) {
+	screen := &MemoryScreen{Columns: 30, Lines: 6}
+	keys := make(chan Key, 3)
+	resize := make(chan struct{}, 1)
+	keys <- KeyDown
+	resize <- struct{}{}
+
+	v := NewViewer(createTestTable())
+	done := make(chan error)
+	go func() { done <- v.Loop(screen, keys, resize) }()
+	keys <- 'q'
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if screen.Frames < 2 || len(screen.Frame) != 6 {
+		t.Fatalf("Unexpected screen state after %d frames: %q", screen.Frames, screen.Frame)
+	}
+	if !strings.HasPrefix(screen.Frame[5], "lines 2-3/21") {
+		t.Fatalf("Unexpected status line %q", screen.Frame[5])
+	}
+}